@@ -0,0 +1,145 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github-stats/internal/config"
+	"github-stats/internal/display"
+	"github-stats/internal/github"
+	"github-stats/internal/roster"
+	"github-stats/internal/snapshot"
+
+	"github.com/fatih/color"
+)
+
+const batchRateLimitReserve = 100
+
+type batchRecord struct {
+	Login  string            `json:"login"`
+	Team   string            `json:"team,omitempty"`
+	Fields map[string]string `json:"fields,omitempty"`
+	Stats  *github.UserStats `json:"stats,omitempty"`
+	Error  string            `json:"error,omitempty"`
+}
+
+var batchExtensions = map[string]string{
+	"table": "txt",
+	"json":  "json",
+}
+
+func runBatch(ctx context.Context, client *github.Client, statsCalc *github.StatsCalculator, store *snapshot.Store, out io.Writer, cfg *config.Config) error {
+	entries, err := loadRoster(cfg)
+	if err != nil {
+		return err
+	}
+
+	if cfg.OutputDir != "" {
+		if err := os.MkdirAll(cfg.OutputDir, 0o755); err != nil {
+			return fmt.Errorf("failed to create output directory: %w", err)
+		}
+	}
+
+	cyan := color.New(color.FgCyan, color.Bold)
+	fmt.Fprintln(os.Stderr)
+	_, _ = cyan.Fprintf(os.Stderr, "🚀 Processing %d users...\n", len(entries))
+	fmt.Fprintln(os.Stderr)
+
+	encoder := json.NewEncoder(out)
+	failures := make(map[string]string)
+
+	for i, entry := range entries {
+		remaining, reset, err := client.RateLimitRemaining()
+		if err == nil && remaining < batchRateLimitReserve {
+			reason := fmt.Sprintf("skipped: rate limit budget exhausted (resets at %s)", reset.Format("15:04:05"))
+			for _, skipped := range entries[i:] {
+				failures[skipped.Login] = reason
+			}
+			break
+		}
+
+		display.DisplayProgress(fmt.Sprintf("[%d/%d] Analyzing %s", i+1, len(entries), entry.Login))
+
+		record := batchRecord{Login: entry.Login, Team: entry.Team, Fields: entry.Fields}
+		state := attachState(client, store, entry.Login, cfg.Rebuild)
+		stats, err := statsCalc.Calculate(ctx, entry.Login)
+		if err != nil {
+			failures[entry.Login] = err.Error()
+			record.Error = err.Error()
+		} else {
+			record.Stats = stats
+			saveState(store, state)
+			if !cfg.NoSave {
+				saveSnapshot(store, stats)
+			}
+		}
+
+		if cfg.OutputDir == "" {
+			if err := encoder.Encode(record); err != nil {
+				return fmt.Errorf("failed to write results: %w", err)
+			}
+			continue
+		}
+
+		if stats != nil {
+			if err := writeUserOutput(cfg, entry.Login, stats); err != nil {
+				failures[entry.Login] = err.Error()
+			}
+		}
+	}
+
+	client.SetState(nil)
+	displayBatchSummary(len(entries), failures)
+
+	if len(failures) == len(entries) {
+		return fmt.Errorf("failed to calculate statistics for every user")
+	}
+	return nil
+}
+
+func loadRoster(cfg *config.Config) ([]roster.Entry, error) {
+	if len(cfg.Users) > 0 {
+		return roster.FromLogins(cfg.Users)
+	}
+	return roster.LoadEntries(cfg.UsersFile)
+}
+
+func writeUserOutput(cfg *config.Config, login string, stats *github.UserStats) error {
+	path := filepath.Join(cfg.OutputDir, fmt.Sprintf("%s.%s", login, batchExtensions[cfg.Format]))
+
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", path, err)
+	}
+	defer func() { _ = file.Close() }()
+
+	if err := display.NewFormatter(cfg.Format, file).Display(stats); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
+
+func displayBatchSummary(total int, failures map[string]string) {
+	fmt.Fprintln(os.Stderr)
+	display.DisplaySuccess(fmt.Sprintf("Processed %d of %d users successfully", total-len(failures), total))
+
+	if len(failures) == 0 {
+		return
+	}
+
+	var logins []string
+	for login := range failures {
+		logins = append(logins, login)
+	}
+	sort.Strings(logins)
+
+	display.DisplayWarning(fmt.Sprintf("%d users failed:", len(failures)))
+	for _, login := range logins {
+		fmt.Fprintf(os.Stderr, "  - %s: %s\n", login, failures[login])
+	}
+}
@@ -2,18 +2,31 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"os"
+	"os/signal"
+	"strings"
+	"sync"
 	"time"
 
 	"github-stats/internal/config"
 	"github-stats/internal/display"
 	"github-stats/internal/github"
+	"github-stats/internal/snapshot"
 
 	"github.com/briandowns/spinner"
 	"github.com/fatih/color"
 )
 
+var userlessCommands = map[string]bool{
+	"repo":        true,
+	"org":         true,
+	"compare":     true,
+	"leaderboard": true,
+}
+
 func main() {
 	cfg, err := config.Load()
 	if err != nil {
@@ -21,13 +34,17 @@ func main() {
 		os.Exit(1)
 	}
 
-	ctx := context.Background()
+	// Cancelling on Ctrl-C lets an interrupted full scan keep its checkpoint.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
 
 	client := github.NewClient(ctx, cfg.Token, cfg.MaxWorkers)
 
+	batch := cfg.Command == "" && cfg.HasRoster()
+
 	username := cfg.Username
-	if username == "" {
-		s := spinner.New(spinner.CharSets[14], 100*time.Millisecond)
+	if username == "" && !userlessCommands[cfg.Command] && !batch {
+		s := spinner.New(spinner.CharSets[14], 100*time.Millisecond, spinner.WithWriter(os.Stderr))
 		s.Suffix = " Getting authenticated user..."
 		s.Start()
 
@@ -45,34 +62,512 @@ func main() {
 		display.DisplayWarning(fmt.Sprintf("Rate limit check failed: %v", err))
 	}
 
-	statsCalc := github.NewStatsCalculator(client)
+	statsCalc := github.NewStatsCalculator(client, github.Options{
+		FullScan:       cfg.FullScan,
+		ActivitySource: cfg.ActivitySource,
+		Languages: github.LanguageOptions{
+			IncludeForks:     cfg.IncludeForks,
+			ExcludeLanguages: cfg.ExcludeLanguages,
+			ExcludeRepos:     cfg.LangExcludeRepos,
+			RepoCap:          cfg.LangRepoCap,
+			Weighting:        cfg.LangWeight,
+			TimelineBy:       cfg.LangTimelineBy,
+		},
+		Repos: github.RepoListOptions{
+			Affiliations: cfg.RepoAffiliations,
+			Orgs:         cfg.Orgs,
+		},
+		Filter: github.RepoFilter{
+			Include:       cfg.IncludeRepos,
+			Exclude:       cfg.ExcludeRepos,
+			SkipArchived:  cfg.SkipArchived,
+			SkipTemplates: cfg.SkipTemplates,
+			MinStars:      cfg.MinStars,
+			Topics:        cfg.Topics,
+			ExcludeTopics: cfg.ExcludeTopics,
+		},
+		TopN:  cfg.TopN,
+		TopBy: cfg.TopBy,
+	})
+
+	out, err := openOutput(cfg.Output)
+	if err != nil {
+		display.DisplayError(fmt.Sprintf("Failed to open output: %v", err))
+		os.Exit(1)
+	}
+	defer func() { _ = out.Close() }()
+
+	formatter := display.NewFormatter(cfg.Format, out)
+
+	var store *snapshot.Store
+	if dir, err := snapshot.DefaultDir(); err != nil {
+		display.DisplayWarning(fmt.Sprintf("Snapshot history disabled: %v", err))
+	} else {
+		store = snapshot.NewStore(dir)
+	}
+
+	switch cfg.Command {
+	case "wrapped":
+		err = runWrapped(ctx, statsCalc, formatter, username, cfg.Year)
+	case "stars":
+		err = runStars(ctx, statsCalc, formatter, username)
+	case "repo":
+		err = runRepo(ctx, statsCalc, formatter, cfg.Args[0], cfg.Since)
+	case "org":
+		err = runOrg(ctx, statsCalc, formatter, cfg.Args[0], cfg.Team, cfg.Since)
+	case "compare":
+		err = runCompare(ctx, statsCalc, formatter, cfg.Args)
+	case "leaderboard":
+		err = runLeaderboard(ctx, statsCalc, formatter, cfg)
+	case "history":
+		err = runHistory(formatter, store, username)
+	case "diff":
+		err = runDiff(formatter, store, username, cfg.Args[0], cfg.Args[1])
+	default:
+		if batch {
+			err = runBatch(ctx, client, statsCalc, store, out, cfg)
+		} else {
+			err = runStats(ctx, client, statsCalc, formatter, store, username, cfg)
+		}
+	}
 
+	if err != nil {
+		display.DisplayError(err.Error())
+		_ = out.Close()
+		os.Exit(1)
+	}
+}
+
+func runStats(ctx context.Context, client *github.Client, statsCalc *github.StatsCalculator, formatter *display.Formatter, store *snapshot.Store, username string, cfg *config.Config) error {
 	cyan := color.New(color.FgCyan, color.Bold)
-	fmt.Println()
-	_, _ = cyan.Println("🚀 Fetching GitHub statistics...")
-	fmt.Println()
+	fmt.Fprintln(os.Stderr)
+	_, _ = cyan.Fprintln(os.Stderr, "🚀 Fetching GitHub statistics...")
+	fmt.Fprintln(os.Stderr)
 
-	s := spinner.New(spinner.CharSets[14], 100*time.Millisecond)
+	s := spinner.New(spinner.CharSets[14], 100*time.Millisecond, spinner.WithWriter(os.Stderr))
 	s.Suffix = " Analyzing profile and repositories..."
 	s.Start()
 
-	stats, err := statsCalc.Calculate(ctx, username, cfg.FullScan)
-	s.Stop()
+	// The spinner gives way to a progress bar once a full commit scan starts.
+	bar := display.NewProgressBar("Scanning repositories")
+	var stopSpinner sync.Once
+	client.SetScanProgress(func(done, total int) {
+		stopSpinner.Do(s.Stop)
+		bar.Update(done, total)
+	})
 
+	state := attachState(client, store, username, cfg.Rebuild)
+	var checkpoint *github.ScanCheckpoint
+	if scansAllCommits(cfg) {
+		checkpoint = attachCheckpoint(client, store, username, cfg.Resume)
+	}
+	stats, err := statsCalc.Calculate(ctx, username)
+	stopSpinner.Do(s.Stop)
+	bar.Finish()
+	client.SetScanProgress(nil)
+	client.SetScanCheckpoint(nil, nil)
+
+	// A merged scan can fail without failing Calculate, so only the scan
+	// itself says whether the checkpoint is still needed.
+	if checkpoint != nil {
+		if err == nil && stats.CommitScanComplete {
+			if err := store.ClearCheckpoint(username); err != nil {
+				display.DisplayWarning(err.Error())
+			}
+		} else if len(checkpoint.Completed) > 0 || len(checkpoint.Pending) > 0 {
+			display.DisplayWarning(fmt.Sprintf("Scan progress saved (%d repositories done); rerun with --resume to continue", len(checkpoint.Completed)))
+		}
+	}
 	if err != nil {
-		display.DisplayError(fmt.Sprintf("Failed to calculate statistics: %v", err))
-		os.Exit(1)
+		return fmt.Errorf("failed to calculate statistics: %w", err)
 	}
+	saveState(store, state)
 
 	display.DisplaySuccess("Statistics calculated successfully")
 
-	formatter := display.NewFormatter(cfg.Format)
+	if cfg.Diff {
+		return runStatsDiff(formatter, store, stats, cfg)
+	}
+
+	if !cfg.NoSave {
+		saveSnapshot(store, stats)
+	}
+
 	if err := formatter.Display(stats); err != nil {
-		display.DisplayError(fmt.Sprintf("Failed to display statistics: %v", err))
-		os.Exit(1)
+		return fmt.Errorf("failed to display statistics: %w", err)
+	}
+
+	if cfg.GraphOut != "" {
+		if err := writeGraph(cfg.GraphOut, cfg.GraphFormat, stats); err != nil {
+			return err
+		}
+		display.DisplaySuccess(fmt.Sprintf("Collaboration graph written to %s", cfg.GraphOut))
+	}
+	return nil
+}
+
+func writeGraph(path, format string, stats *github.UserStats) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create graph file: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	if err := display.WriteGraph(file, format, stats); err != nil {
+		return fmt.Errorf("failed to write collaboration graph: %w", err)
+	}
+	return nil
+}
+
+// attachState makes the client fetch incrementally against the state saved
+// by the previous run for username. With rebuild the stored state is
+// discarded and replaced once this run completes.
+func attachState(client *github.Client, store *snapshot.Store, username string, rebuild bool) *github.IncrementalState {
+	if store == nil {
+		client.SetState(nil)
+		return nil
+	}
+
+	state := github.NewIncrementalState(username)
+	if !rebuild {
+		var err error
+		if state, err = store.LoadState(username); err != nil {
+			display.DisplayWarning(fmt.Sprintf("Starting from a clean state: %v", err))
+		}
+	}
+	client.SetState(state)
+	return state
+}
+
+// scansAllCommits reports whether cfg asks for a commit scan of every
+// repository, the only kind of scan that is checkpointed.
+func scansAllCommits(cfg *config.Config) bool {
+	return cfg.FullScan || cfg.ActivitySource == github.ActivitySourceCommits || cfg.ActivitySource == github.ActivitySourceMerged
+}
+
+// attachCheckpoint makes full commit scans checkpoint their progress for
+// username, continuing from the saved checkpoint when resume is set.
+func attachCheckpoint(client *github.Client, store *snapshot.Store, username string, resume bool) *github.ScanCheckpoint {
+	if store == nil {
+		return nil
+	}
+
+	var checkpoint *github.ScanCheckpoint
+	if resume {
+		var err error
+		if checkpoint, err = store.LoadCheckpoint(username); err != nil {
+			display.DisplayWarning(fmt.Sprintf("Starting a new scan: %v", err))
+		} else if checkpoint == nil {
+			display.DisplayWarning("No interrupted scan to resume; starting a new scan")
+		} else {
+			display.DisplayProgress(fmt.Sprintf("Resuming scan started %s with %d repositories done", checkpoint.StartedAt.Local().Format("2006-01-02 15:04"), len(checkpoint.Completed)))
+		}
+	}
+	if checkpoint == nil {
+		checkpoint = github.NewScanCheckpoint(username)
+	}
+
+	var warnOnce sync.Once
+	client.SetScanCheckpoint(checkpoint, func(cp *github.ScanCheckpoint) {
+		if err := store.SaveCheckpoint(cp); err != nil {
+			warnOnce.Do(func() { display.DisplayWarning(err.Error()) })
+		}
+	})
+	return checkpoint
+}
+
+func saveState(store *snapshot.Store, state *github.IncrementalState) {
+	if store == nil || state == nil {
+		return
+	}
+	if err := store.SaveState(state, time.Now()); err != nil {
+		display.DisplayWarning(fmt.Sprintf("Failed to save incremental state: %v", err))
+	}
+}
+
+func saveSnapshot(store *snapshot.Store, stats *github.UserStats) {
+	if store == nil {
+		return
+	}
+	if err := store.Save(stats, time.Now()); err != nil {
+		display.DisplayWarning(fmt.Sprintf("Failed to save snapshot: %v", err))
+	}
+}
+
+func runHistory(formatter *display.Formatter, store *snapshot.Store, username string) error {
+	if store == nil {
+		return fmt.Errorf("snapshot history is unavailable")
+	}
+
+	snapshots, err := store.Load(username)
+	if err != nil {
+		return fmt.Errorf("failed to load history: %w", err)
+	}
+
+	if err := formatter.DisplayHistory(snapshot.BuildHistory(username, snapshots)); err != nil {
+		return fmt.Errorf("failed to display history: %w", err)
+	}
+	return nil
+}
+
+func runStatsDiff(formatter *display.Formatter, store *snapshot.Store, stats *github.UserStats, cfg *config.Config) error {
+	var before *snapshot.Snapshot
+	if cfg.DiffAgainst != "" {
+		var err error
+		if before, err = snapshot.LoadFile(cfg.DiffAgainst); err != nil {
+			return err
+		}
+	} else {
+		if store == nil {
+			return fmt.Errorf("snapshot history is unavailable")
+		}
+		snapshots, err := store.Load(stats.Username)
+		if err != nil {
+			return fmt.Errorf("failed to load history: %w", err)
+		}
+		if len(snapshots) > 0 {
+			before = &snapshots[len(snapshots)-1]
+		}
+	}
+
+	// Saving before comparing lets a first --diff run become the baseline
+	// for the next one instead of failing every time.
+	if !cfg.NoSave {
+		saveSnapshot(store, stats)
+	}
+
+	if before == nil {
+		message := fmt.Sprintf("No saved snapshot for %s to compare against", stats.Username)
+		if cfg.NoSave {
+			message += "; run without --no-save to record a baseline"
+		} else {
+			message += "; this run was saved as the baseline for the next --diff"
+		}
+		display.DisplayWarning(message)
+		return nil
+	}
+
+	after := snapshot.Snapshot{Timestamp: time.Now().UTC(), Stats: stats}
+	if err := formatter.DisplayDiff(snapshot.Diff(*before, after)); err != nil {
+		return fmt.Errorf("failed to display diff: %w", err)
+	}
+	return nil
+}
+
+func runDiff(formatter *display.Formatter, store *snapshot.Store, username, from, to string) error {
+	before, err := resolveSnapshot(store, username, from)
+	if err != nil {
+		return err
+	}
+	after, err := resolveSnapshot(store, username, to)
+	if err != nil {
+		return err
+	}
+
+	if err := formatter.DisplayDiff(snapshot.Diff(*before, *after)); err != nil {
+		return fmt.Errorf("failed to display diff: %w", err)
+	}
+	return nil
+}
+
+func resolveSnapshot(store *snapshot.Store, username, ref string) (*snapshot.Snapshot, error) {
+	if ref == "latest" || ref == "previous" {
+		return loadStoredSnapshot(store, username, ref)
 	}
+	return snapshot.LoadFile(ref)
 }
 
+func loadStoredSnapshot(store *snapshot.Store, username, ref string) (*snapshot.Snapshot, error) {
+	if store == nil {
+		return nil, fmt.Errorf("snapshot history is unavailable")
+	}
+
+	snapshots, err := store.Load(username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load history: %w", err)
+	}
+
+	index := len(snapshots) - 1
+	if ref == "previous" {
+		index--
+	}
+	if index < 0 {
+		return nil, fmt.Errorf("no %s snapshot saved for %s", ref, username)
+	}
+	return &snapshots[index], nil
+}
+
+func runWrapped(ctx context.Context, statsCalc *github.StatsCalculator, formatter *display.Formatter, username string, year int) error {
+	s := spinner.New(spinner.CharSets[14], 100*time.Millisecond, spinner.WithWriter(os.Stderr))
+	s.Suffix = fmt.Sprintf(" Building %d wrapped report...", year)
+	s.Start()
+
+	report, err := statsCalc.CalculateWrapped(ctx, username, year)
+	s.Stop()
+
+	if err != nil {
+		return fmt.Errorf("failed to build wrapped report: %w", err)
+	}
+
+	display.DisplaySuccess(fmt.Sprintf("Wrapped report for %d built successfully", year))
+
+	if err := formatter.DisplayWrapped(report); err != nil {
+		return fmt.Errorf("failed to display wrapped report: %w", err)
+	}
+	return nil
+}
+
+func runStars(ctx context.Context, statsCalc *github.StatsCalculator, formatter *display.Formatter, username string) error {
+	s := spinner.New(spinner.CharSets[14], 100*time.Millisecond, spinner.WithWriter(os.Stderr))
+	s.Suffix = " Fetching stargazers..."
+	s.Start()
+
+	history, err := statsCalc.CalculateStarHistory(ctx, username)
+	s.Stop()
+
+	if err != nil {
+		return fmt.Errorf("failed to build star history: %w", err)
+	}
+
+	display.DisplaySuccess("Star history built successfully")
+
+	if err := formatter.DisplayStars(history); err != nil {
+		return fmt.Errorf("failed to display star history: %w", err)
+	}
+	return nil
+}
+
+func runRepo(ctx context.Context, statsCalc *github.StatsCalculator, formatter *display.Formatter, fullName string, since time.Time) error {
+	owner, name, _ := strings.Cut(fullName, "/")
+
+	s := spinner.New(spinner.CharSets[14], 100*time.Millisecond, spinner.WithWriter(os.Stderr))
+	s.Suffix = fmt.Sprintf(" Analyzing %s...", fullName)
+	s.Start()
+
+	report, err := statsCalc.CalculateRepo(ctx, owner, name, since)
+	s.Stop()
+
+	if err != nil {
+		return fmt.Errorf("failed to calculate repository statistics: %w", err)
+	}
+
+	display.DisplaySuccess("Repository statistics calculated successfully")
+
+	if err := formatter.DisplayRepo(report); err != nil {
+		return fmt.Errorf("failed to display repository statistics: %w", err)
+	}
+	return nil
+}
+
+func runOrg(ctx context.Context, statsCalc *github.StatsCalculator, formatter *display.Formatter, org, team string, since time.Time) error {
+	s := spinner.New(spinner.CharSets[14], 100*time.Millisecond, spinner.WithWriter(os.Stderr))
+	s.Suffix = fmt.Sprintf(" Analyzing members of %s...", org)
+	s.Start()
+
+	report, err := statsCalc.CalculateOrg(ctx, org, team, since)
+	s.Stop()
+
+	if err != nil {
+		return fmt.Errorf("failed to calculate organization statistics: %w", err)
+	}
+
+	display.DisplaySuccess(fmt.Sprintf("Analyzed %d members", report.Members))
+
+	if err := formatter.DisplayOrg(report); err != nil {
+		return fmt.Errorf("failed to display organization statistics: %w", err)
+	}
+	return nil
+}
+
+func runCompare(ctx context.Context, statsCalc *github.StatsCalculator, formatter *display.Formatter, usernames []string) error {
+	s := spinner.New(spinner.CharSets[14], 100*time.Millisecond, spinner.WithWriter(os.Stderr))
+	s.Suffix = fmt.Sprintf(" Analyzing %d users...", len(usernames))
+	s.Start()
+
+	comparison, err := statsCalc.CalculateComparison(ctx, usernames)
+	s.Stop()
+
+	if err != nil {
+		return fmt.Errorf("failed to compare users: %w", err)
+	}
+
+	display.DisplaySuccess("Comparison calculated successfully")
+
+	if err := formatter.DisplayComparison(comparison); err != nil {
+		return fmt.Errorf("failed to display comparison: %w", err)
+	}
+	return nil
+}
+
+func runLeaderboard(ctx context.Context, statsCalc *github.StatsCalculator, formatter *display.Formatter, cfg *config.Config) error {
+	entries, err := loadRoster(cfg)
+	if err != nil {
+		return err
+	}
+	usernames := make([]string, len(entries))
+	for i, entry := range entries {
+		usernames[i] = entry.Login
+	}
+
+	var previous *github.Leaderboard
+	if cfg.Previous != "" {
+		previous, err = loadLeaderboard(cfg.Previous)
+		if err != nil {
+			return err
+		}
+	}
+
+	s := spinner.New(spinner.CharSets[14], 100*time.Millisecond, spinner.WithWriter(os.Stderr))
+	s.Suffix = fmt.Sprintf(" Ranking %d users...", len(usernames))
+	s.Start()
+
+	board, err := statsCalc.CalculateLeaderboard(ctx, usernames, cfg.Metric, cfg.Since)
+	s.Stop()
+
+	if err != nil {
+		return fmt.Errorf("failed to build leaderboard: %w", err)
+	}
+
+	if previous != nil {
+		if err := board.ComparePrevious(previous); err != nil {
+			display.DisplayWarning(fmt.Sprintf("Skipping rank changes: %v", err))
+		}
+	}
+
+	display.DisplaySuccess(fmt.Sprintf("Ranked %d users", len(board.Entries)))
+
+	if err := formatter.DisplayLeaderboard(board); err != nil {
+		return fmt.Errorf("failed to display leaderboard: %w", err)
+	}
+	return nil
+}
+
+func loadLeaderboard(path string) (*github.Leaderboard, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read previous leaderboard: %w", err)
+	}
+
+	var board github.Leaderboard
+	if err := json.Unmarshal(data, &board); err != nil {
+		return nil, fmt.Errorf("failed to parse previous leaderboard: %w", err)
+	}
+	return &board, nil
+}
+
+func openOutput(path string) (io.WriteCloser, error) {
+	if path == "" {
+		return nopCloser{os.Stdout}, nil
+	}
+	return os.Create(path)
+}
+
+type nopCloser struct {
+	io.Writer
+}
+
+func (nopCloser) Close() error { return nil }
+
 func checkRateLimit(client *github.Client) error {
 	limits, err := client.CheckRateLimit()
 	if err != nil {
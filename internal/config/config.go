@@ -4,16 +4,89 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"path"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 )
 
 type Config struct {
-	Token      string
-	Username   string
-	FullScan   bool
-	Format     string
-	StatsOnly  []string
-	MaxWorkers int
+	Command        string
+	Args           []string
+	Token          string
+	Username       string
+	FullScan       bool
+	Format         string
+	Output         string
+	StatsOnly      []string
+	MaxWorkers     int
+	Year           int
+	ActivitySource string
+
+	IncludeForks     bool
+	ExcludeLanguages []string
+	LangExcludeRepos []string
+	LangRepoCap      int64
+	LangWeight       string
+	LangTimelineBy   string
+
+	RepoAffiliations []string
+	Orgs             []string
+
+	IncludeRepos  []string
+	ExcludeRepos  []string
+	SkipArchived  bool
+	SkipTemplates bool
+	MinStars      int
+	Topics        []string
+	ExcludeTopics []string
+
+	TopN  int
+	TopBy string
+
+	Since time.Time
+	Team  string
+
+	Users     []string
+	UsersFile string
+	OutputDir string
+	Metric    string
+	Previous  string
+
+	GraphFormat string
+	GraphOut    string
+
+	NoSave      bool
+	Diff        bool
+	DiffAgainst string
+
+	Rebuild bool
+	Resume  bool
+}
+
+var languageWeights = []string{"bytes", "repos"}
+
+var repoAffiliations = []string{"owner", "collaborator", "organization_member"}
+
+var timelineBuckets = []string{"created", "pushed"}
+
+var topRankings = []string{"stars", "forks", "pushed", "commits", "size", "issues"}
+
+var graphFormats = []string{"dot", "graphml", "json"}
+
+var activitySources = []string{"auto", "calendar", "events", "commits", "merged"}
+
+var commandFormats = map[string][]string{
+	"":            {"table", "json"},
+	"wrapped":     {"table", "json", "markdown", "svg"},
+	"stars":       {"table", "json", "csv", "svg"},
+	"repo":        {"table", "json"},
+	"org":         {"table", "json", "csv"},
+	"compare":     {"table", "json"},
+	"leaderboard": {"table", "json", "markdown"},
+	"history":     {"table", "json"},
+	"diff":        {"table", "json", "markdown"},
 }
 
 func Load() (*Config, error) {
@@ -21,34 +94,123 @@ func Load() (*Config, error) {
 
 	flag.StringVar(&cfg.Token, "token", "", "GitHub Personal Access Token (overrides GITHUB_TOKEN env)")
 	flag.StringVar(&cfg.Username, "user", "", "GitHub username to analyze (defaults to authenticated user)")
-	flag.BoolVar(&cfg.FullScan, "full", false, "Perform full history scan (slower but complete)")
-	flag.StringVar(&cfg.Format, "format", "table", "Output format: table, json")
+	flag.BoolVar(&cfg.FullScan, "full", false, "Perform full history scan of every repository's commits, merged with the calendar and events (slower but complete)")
+	flag.StringVar(&cfg.Format, "format", "table", "Output format: table, json (wrapped also supports markdown, svg; stars supports csv, svg; org supports csv)")
+	flag.StringVar(&cfg.Output, "output", "", "Write output to file instead of stdout")
 	statsOnly := flag.String("stats", "", "Comma-separated stats to show: profile,repos,streak,languages,prs,issues,reviews (default: all)")
 	flag.IntVar(&cfg.MaxWorkers, "workers", 10, "Maximum concurrent API requests")
+	flag.StringVar(&cfg.ActivitySource, "activity-source", "auto", "Commit activity source: auto, calendar, events, commits, merged")
+	flag.BoolVar(&cfg.IncludeForks, "include-forks", false, "Include forked repositories in language statistics")
+	excludeLang := flag.String("exclude-lang", "", "Comma-separated languages to exclude (e.g. \"HTML,Jupyter Notebook\")")
+	langExcludeRepo := flag.String("lang-exclude-repo", "", "Comma-separated repository globs to exclude from language statistics")
+	flag.Int64Var(&cfg.LangRepoCap, "lang-repo-cap", 0, "Cap any single repository's language bytes (0 = no cap)")
+	flag.StringVar(&cfg.LangWeight, "lang-weight", "bytes", "Language weighting: bytes, repos")
+	flag.StringVar(&cfg.LangTimelineBy, "lang-timeline-by", "created", "Bucket the language timeline by repository year: created, pushed (full scan uses commits)")
+	repoAffiliation := flag.String("repo-affiliation", "owner", "Comma-separated repository affiliations: owner, collaborator, organization_member (only org repositories the user has contributed to)")
+	orgs := flag.String("org", "", "Comma-separated organizations to restrict repositories to")
+	includeRepo := flag.String("include-repo", "", "Comma-separated repository globs to include (matches name or owner/name)")
+	excludeRepo := flag.String("exclude-repo", "", "Comma-separated repository globs to exclude (matches name or owner/name)")
+	flag.BoolVar(&cfg.SkipArchived, "skip-archived", false, "Exclude archived repositories")
+	flag.BoolVar(&cfg.SkipTemplates, "skip-templates", false, "Exclude template repositories")
+	flag.IntVar(&cfg.MinStars, "min-stars", 0, "Exclude repositories with fewer stars")
+	topics := flag.String("topic", "", "Comma-separated topics; only repositories with at least one are included")
+	excludeTopics := flag.String("exclude-topic", "", "Comma-separated topics; repositories with any of them are excluded")
+	flag.IntVar(&cfg.TopN, "top", 5, "Number of top repositories to show")
+	flag.StringVar(&cfg.TopBy, "top-by", "stars", "Rank top repositories by: stars, forks, pushed, commits, size, issues")
+	since := flag.String("since", "90d", "Start of the reporting window: YYYY-MM-DD or a number of days such as 90d")
+	flag.StringVar(&cfg.Team, "team", "", "Team slug to restrict the org report to")
+	users := flag.String("users", "", "Roster file with one username per line, or CSV with login and team columns; a comma-separated list of usernames is also accepted")
+	usersFile := flag.String("users-file", "", "Process every user in a roster file (same format as --users)")
+	flag.StringVar(&cfg.OutputDir, "output-dir", "", "Write one output file per user in batch mode instead of a JSON lines stream")
+	flag.StringVar(&cfg.Metric, "metric", "contributions", "Leaderboard metric or weighted formula, e.g. \"prs_merged*3+reviews\"")
+	flag.StringVar(&cfg.Previous, "previous", "", "Previous leaderboard JSON output to compute rank changes against")
+	flag.StringVar(&cfg.GraphFormat, "graph-format", "dot", "Collaboration graph export format: dot, graphml, json")
+	flag.StringVar(&cfg.GraphOut, "graph-out", "", "Write the collaboration graph to this file")
+	flag.BoolVar(&cfg.NoSave, "no-save", false, "Do not save a snapshot of this run to the local history store")
+	flag.BoolVar(&cfg.Diff, "diff", false, "Show changes since the last saved run instead of the full report")
+	flag.StringVar(&cfg.DiffAgainst, "diff-against", "", "Show changes since a saved JSON file (implies --diff)")
+	flag.BoolVar(&cfg.Rebuild, "rebuild", false, "Ignore stored incremental state and recompute everything from scratch")
+	flag.BoolVar(&cfg.Resume, "resume", false, "Resume an interrupted full scan from its last checkpoint")
+	flag.IntVar(&cfg.Year, "year", time.Now().Year(), "Calendar year for the wrapped report")
 
 	flag.Usage = func() {
-		fmt.Fprintf(os.Stderr, "Usage: github-stats [options]\n\n")
+		fmt.Fprintf(os.Stderr, "Usage: github-stats [command] [options]\n\n")
 		fmt.Fprintf(os.Stderr, "A CLI tool to display GitHub profile statistics.\n\n")
+		fmt.Fprintf(os.Stderr, "Commands:\n")
+		fmt.Fprintf(os.Stderr, "  wrapped      Year-in-review report for a single calendar year\n")
+		fmt.Fprintf(os.Stderr, "  stars        Star history for owned repositories\n")
+		fmt.Fprintf(os.Stderr, "  repo         Project statistics for a single repository (repo owner/name)\n")
+		fmt.Fprintf(os.Stderr, "  org          Aggregate dashboard for an organization's members (org name)\n")
+		fmt.Fprintf(os.Stderr, "  compare      Side-by-side comparison of several users (compare user1 user2 ...)\n")
+		fmt.Fprintf(os.Stderr, "  leaderboard  Rank a roster of users by a metric (--users roster.txt)\n")
+		fmt.Fprintf(os.Stderr, "  history      Trends across saved snapshots of previous runs\n")
+		fmt.Fprintf(os.Stderr, "  diff         Changes between two snapshots (files, or latest/previous)\n\n")
 		fmt.Fprintf(os.Stderr, "Options:\n")
 		flag.PrintDefaults()
 		fmt.Fprintf(os.Stderr, "\nExamples:\n")
 		fmt.Fprintf(os.Stderr, "  github-stats --user octocat\n")
 		fmt.Fprintf(os.Stderr, "  github-stats --user octocat --full --format json\n")
+		fmt.Fprintf(os.Stderr, "  github-stats --user octocat --full --resume\n")
 		fmt.Fprintf(os.Stderr, "  github-stats --token ghp_xxx --user octocat\n")
+		fmt.Fprintf(os.Stderr, "  github-stats --user octocat --top 10 --top-by pushed\n")
+		fmt.Fprintf(os.Stderr, "  github-stats --users-file team.csv --format json --output results.jsonl\n")
+		fmt.Fprintf(os.Stderr, "  github-stats --user octocat --graph-out network.graphml --graph-format graphml\n")
+		fmt.Fprintf(os.Stderr, "  github-stats wrapped --user octocat --year 2025 --format svg --output wrapped.svg\n")
+		fmt.Fprintf(os.Stderr, "  github-stats stars --user octocat --format csv --output stars.csv\n")
+		fmt.Fprintf(os.Stderr, "  github-stats repo cli/cli --since 30d\n")
+		fmt.Fprintf(os.Stderr, "  github-stats org my-org --team platform --format csv\n")
+		fmt.Fprintf(os.Stderr, "  github-stats compare alice bob carol --format json\n")
+		fmt.Fprintf(os.Stderr, "  github-stats leaderboard --users roster.txt --metric prs_merged --since 2025-10-01\n")
+		fmt.Fprintf(os.Stderr, "  github-stats history --user octocat\n")
+		fmt.Fprintf(os.Stderr, "  github-stats --user octocat --diff --format markdown\n")
+		fmt.Fprintf(os.Stderr, "  github-stats diff last-week.json previous --user octocat\n")
 		fmt.Fprintf(os.Stderr, "\nAuthentication:\n")
 		fmt.Fprintf(os.Stderr, "  Set GITHUB_TOKEN environment variable or use --token flag\n")
 		fmt.Fprintf(os.Stderr, "  Create token at: https://github.com/settings/tokens\n")
+		fmt.Fprintf(os.Stderr, "\nHistory:\n")
+		fmt.Fprintf(os.Stderr, "  Snapshots are appended to $XDG_DATA_HOME/github-stats (default ~/.local/share/github-stats)\n")
+	}
+
+	positional := parseInterleaved(flag.CommandLine, os.Args[1:])
+	if len(positional) > 0 {
+		cfg.Command = positional[0]
+		cfg.Args = positional[1:]
 	}
 
-	flag.Parse()
+	formats, ok := commandFormats[cfg.Command]
+	if !ok {
+		return nil, fmt.Errorf("unknown command: %s", cfg.Command)
+	}
+
+	var err error
+	if cfg.Users, cfg.UsersFile, err = resolveUsers(*users, *usersFile); err != nil {
+		return nil, err
+	}
 
-	if *statsOnly != "" {
-		cfg.StatsOnly = strings.Split(*statsOnly, ",")
-		for i, s := range cfg.StatsOnly {
-			cfg.StatsOnly[i] = strings.TrimSpace(s)
+	if cfg.DiffAgainst != "" {
+		cfg.Diff = true
+	}
+	if cfg.Diff {
+		if cfg.Command != "" || cfg.HasRoster() {
+			return nil, fmt.Errorf("diff is only supported for a single user's statistics")
 		}
+		formats = commandFormats["diff"]
+	}
+
+	if cfg.Resume && (cfg.Command != "" || cfg.HasRoster()) {
+		return nil, fmt.Errorf("resume is only supported for a single user's statistics")
 	}
 
+	cfg.StatsOnly = splitList(*statsOnly)
+	cfg.ExcludeLanguages = splitList(*excludeLang)
+	cfg.LangExcludeRepos = splitList(*langExcludeRepo)
+	cfg.RepoAffiliations = splitList(*repoAffiliation)
+	cfg.Orgs = splitList(*orgs)
+	cfg.IncludeRepos = splitList(*includeRepo)
+	cfg.ExcludeRepos = splitList(*excludeRepo)
+	cfg.Topics = splitList(*topics)
+	cfg.ExcludeTopics = splitList(*excludeTopics)
+
 	if cfg.Token == "" {
 		cfg.Token = os.Getenv("GITHUB_TOKEN")
 	}
@@ -57,25 +219,205 @@ func Load() (*Config, error) {
 		return nil, fmt.Errorf("GitHub token is required. Set GITHUB_TOKEN environment variable or use --token flag")
 	}
 
-	if cfg.Format != "table" && cfg.Format != "json" {
-		return nil, fmt.Errorf("invalid format: %s (must be 'table' or 'json')", cfg.Format)
+	if !slices.Contains(formats, cfg.Format) {
+		return nil, fmt.Errorf("invalid format: %s (must be one of: %s)", cfg.Format, strings.Join(formats, ", "))
+	}
+
+	if !slices.Contains(activitySources, cfg.ActivitySource) {
+		return nil, fmt.Errorf("invalid activity source: %s (must be one of: %s)", cfg.ActivitySource, strings.Join(activitySources, ", "))
+	}
+
+	// Only commit scans are checkpointed, so resuming implies one.
+	if cfg.Resume {
+		if cfg.ActivitySource == "calendar" || cfg.ActivitySource == "events" {
+			return nil, fmt.Errorf("resume requires a commit scan, not the %s activity source", cfg.ActivitySource)
+		}
+		cfg.FullScan = true
+	}
+
+	for _, affiliation := range cfg.RepoAffiliations {
+		if !slices.Contains(repoAffiliations, affiliation) {
+			return nil, fmt.Errorf("invalid repository affiliation: %s (must be one of: %s)", affiliation, strings.Join(repoAffiliations, ", "))
+		}
+	}
+
+	if !slices.Contains(languageWeights, cfg.LangWeight) {
+		return nil, fmt.Errorf("invalid language weight: %s (must be one of: %s)", cfg.LangWeight, strings.Join(languageWeights, ", "))
+	}
+
+	if !slices.Contains(timelineBuckets, cfg.LangTimelineBy) {
+		return nil, fmt.Errorf("invalid language timeline bucket: %s (must be one of: %s)", cfg.LangTimelineBy, strings.Join(timelineBuckets, ", "))
+	}
+
+	for _, patterns := range [][]string{cfg.IncludeRepos, cfg.ExcludeRepos, cfg.LangExcludeRepos} {
+		for _, pattern := range patterns {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return nil, fmt.Errorf("invalid repository pattern: %s", pattern)
+			}
+		}
+	}
+
+	if cfg.MinStars < 0 {
+		return nil, fmt.Errorf("min-stars must not be negative")
+	}
+
+	if !slices.Contains(topRankings, cfg.TopBy) {
+		return nil, fmt.Errorf("invalid top ranking: %s (must be one of: %s)", cfg.TopBy, strings.Join(topRankings, ", "))
+	}
+
+	if cfg.TopN < 1 {
+		return nil, fmt.Errorf("top must be at least 1")
+	}
+
+	if cfg.LangRepoCap < 0 {
+		return nil, fmt.Errorf("lang-repo-cap must not be negative")
 	}
 
 	if cfg.MaxWorkers < 1 || cfg.MaxWorkers > 50 {
 		return nil, fmt.Errorf("workers must be between 1 and 50")
 	}
 
+	sinceTime, err := parseSince(*since, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	cfg.Since = sinceTime
+
+	if cfg.Command == "repo" && (len(cfg.Args) != 1 || !validRepoName(cfg.Args[0])) {
+		return nil, fmt.Errorf("repo command requires a single owner/name argument")
+	}
+
+	if cfg.Command == "org" && len(cfg.Args) != 1 {
+		return nil, fmt.Errorf("org command requires a single organization name argument")
+	}
+
+	if cfg.Command == "diff" && len(cfg.Args) != 2 {
+		return nil, fmt.Errorf("diff command requires two snapshots (file paths, latest or previous)")
+	}
+
+	if cfg.Command == "compare" && len(cfg.Args) < 2 {
+		return nil, fmt.Errorf("compare command requires at least two usernames")
+	}
+
+	if cfg.Command == "leaderboard" && !cfg.HasRoster() {
+		return nil, fmt.Errorf("leaderboard command requires --users or --users-file")
+	}
+
+	if cfg.Command == "" && cfg.HasRoster() {
+		if cfg.Username != "" {
+			return nil, fmt.Errorf("user cannot be combined with users or users-file")
+		}
+		if cfg.OutputDir == "" && cfg.Format != "json" {
+			return nil, fmt.Errorf("batch mode writes JSON lines unless --output-dir is set; use --format json")
+		}
+	}
+
+	if cfg.OutputDir != "" && (cfg.Command != "" || !cfg.HasRoster()) {
+		return nil, fmt.Errorf("output-dir is only supported in batch mode (--users or --users-file)")
+	}
+
+	if !slices.Contains(graphFormats, cfg.GraphFormat) {
+		return nil, fmt.Errorf("invalid graph format: %s (must be one of: %s)", cfg.GraphFormat, strings.Join(graphFormats, ", "))
+	}
+
+	if cfg.GraphOut != "" && (cfg.Command != "" || cfg.HasRoster()) {
+		return nil, fmt.Errorf("graph-out is only supported for a single user's statistics")
+	}
+
+	if cfg.Team != "" && cfg.Command != "org" {
+		return nil, fmt.Errorf("team is only supported by the org command")
+	}
+
+	if cfg.Command == "wrapped" && (cfg.Year < 2008 || cfg.Year > time.Now().Year()) {
+		return nil, fmt.Errorf("year must be between 2008 and %d", time.Now().Year())
+	}
+
 	return cfg, nil
 }
 
+// HasRoster reports whether a list of users was given inline or as a file.
+func (c *Config) HasRoster() bool {
+	return len(c.Users) > 0 || c.UsersFile != ""
+}
+
 func (c *Config) ShouldShowStat(stat string) bool {
 	if len(c.StatsOnly) == 0 {
 		return true
 	}
-	for _, s := range c.StatsOnly {
-		if s == stat {
-			return true
+	return slices.Contains(c.StatsOnly, stat)
+}
+
+// parseInterleaved parses flags wherever they appear, returning the
+// positional arguments in order. The flag package alone stops at the first
+// positional, which would drop flags written after a subcommand's arguments.
+func parseInterleaved(fs *flag.FlagSet, args []string) []string {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return positional
+		}
+		rest := fs.Args()
+		if len(rest) == 0 {
+			return positional
+		}
+		// Parse consumes a "--" terminator, after which nothing is a flag.
+		if len(args) >= len(rest)+1 && args[len(args)-len(rest)-1] == "--" {
+			return append(positional, rest...)
+		}
+		positional = append(positional, rest[0])
+		args = rest[1:]
+	}
+}
+
+func parseSince(value string, now time.Time) (time.Time, error) {
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil || n < 1 {
+			return time.Time{}, fmt.Errorf("invalid since: %s (use YYYY-MM-DD or a number of days such as 90d)", value)
 		}
+		return now.AddDate(0, 0, -n), nil
+	}
+
+	t, err := time.Parse("2006-01-02", value)
+	if err != nil || t.After(now) {
+		return time.Time{}, fmt.Errorf("invalid since: %s (use YYYY-MM-DD or a number of days such as 90d)", value)
+	}
+	return t, nil
+}
+
+func validRepoName(name string) bool {
+	owner, repo, ok := strings.Cut(name, "/")
+	return ok && owner != "" && repo != "" && !strings.Contains(repo, "/")
+}
+
+func splitList(value string) []string {
+	if value == "" {
+		return nil
+	}
+	items := strings.Split(value, ",")
+	for i, item := range items {
+		items[i] = strings.TrimSpace(item)
+	}
+	return items
+}
+
+// resolveUsers splits the roster flags into inline logins and a roster
+// file. --users names a roster file when one exists at that path and is
+// otherwise read as a comma-separated list of logins.
+func resolveUsers(users, usersFile string) ([]string, string, error) {
+	if users != "" && usersFile != "" {
+		return nil, "", fmt.Errorf("users and users-file cannot be combined")
+	}
+	if users == "" {
+		return nil, usersFile, nil
+	}
+	if info, err := os.Stat(users); err == nil && info.Mode().IsRegular() {
+		return nil, users, nil
+	}
+	// Logins never contain dots or slashes, so such a value is a roster
+	// file that could not be found rather than a username.
+	if strings.ContainsAny(users, "./") && !strings.Contains(users, ",") {
+		return nil, "", fmt.Errorf("roster file not found: %s", users)
 	}
-	return false
+	return splitList(users), "", nil
 }
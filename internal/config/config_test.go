@@ -0,0 +1,78 @@
+package config
+
+import (
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestParseInterleaved(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		positional []string
+		format     string
+		team       string
+	}{
+		{"flags only", []string{"--format", "json"}, nil, "json", ""},
+		{"flags after positional", []string{"repo", "cli/cli", "--format", "json"}, []string{"repo", "cli/cli"}, "json", ""},
+		{"flags between positionals", []string{"org", "--team", "platform", "my-org", "--format=csv"}, []string{"org", "my-org"}, "csv", "platform"},
+		{"flags before command", []string{"--format", "json", "compare", "alice", "bob"}, []string{"compare", "alice", "bob"}, "json", ""},
+		{"terminator", []string{"diff", "--", "--format", "x"}, []string{"diff", "--format", "x"}, "table", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := flag.NewFlagSet("test", flag.ContinueOnError)
+			fs.SetOutput(io.Discard)
+			format := fs.String("format", "table", "")
+			team := fs.String("team", "", "")
+
+			got := parseInterleaved(fs, tt.args)
+			if !reflect.DeepEqual(got, tt.positional) {
+				t.Errorf("positional = %q, want %q", got, tt.positional)
+			}
+			if *format != tt.format || *team != tt.team {
+				t.Errorf("format = %q, team = %q, want %q, %q", *format, *team, tt.format, tt.team)
+			}
+		})
+	}
+}
+
+func TestResolveUsers(t *testing.T) {
+	roster := filepath.Join(t.TempDir(), "roster.txt")
+	if err := os.WriteFile(roster, []byte("alice\nbob\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		users     string
+		usersFile string
+		logins    []string
+		file      string
+		wantErr   bool
+	}{
+		{"roster file via users", roster, "", nil, roster, false},
+		{"inline list", "alice, bob", "", []string{"alice", "bob"}, "", false},
+		{"single login", "alice", "", []string{"alice"}, "", false},
+		{"users-file", "", roster, nil, roster, false},
+		{"both flags", "alice", roster, nil, "", true},
+		{"missing roster file", "missing.txt", "", nil, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logins, file, err := resolveUsers(tt.users, tt.usersFile)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("resolveUsers() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(logins, tt.logins) || file != tt.file {
+				t.Errorf("resolveUsers() = %q, %q, want %q, %q", logins, file, tt.logins, tt.file)
+			}
+		})
+	}
+}
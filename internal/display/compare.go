@@ -0,0 +1,107 @@
+package display
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github-stats/internal/github"
+
+	"github.com/fatih/color"
+	"github.com/olekukonko/tablewriter"
+	"github.com/olekukonko/tablewriter/tw"
+)
+
+func (f *Formatter) DisplayComparison(comparison *github.Comparison) error {
+	switch f.format {
+	case "json":
+		encoder := json.NewEncoder(f.out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(comparison)
+	case "table":
+		return f.displayComparisonTable(comparison)
+	default:
+		return fmt.Errorf("unsupported format: %s", f.format)
+	}
+}
+
+func (f *Formatter) displayComparisonTable(comparison *github.Comparison) error {
+	cyan := color.New(color.FgCyan, color.Bold)
+	green := color.New(color.FgGreen)
+	yellow := color.New(color.FgYellow)
+	blue := color.New(color.FgBlue)
+	leader := color.New(color.FgGreen, color.Bold)
+
+	_, _ = cyan.Fprintln(f.out, "\n"+strings.Repeat("=", 80))
+	_, _ = cyan.Fprintf(f.out, "  ⚔️  Comparing %s\n", strings.Join(comparison.Users, " vs "))
+	_, _ = cyan.Fprintln(f.out, strings.Repeat("=", 80))
+
+	fmt.Fprintln(f.out)
+	_, _ = green.Fprintln(f.out, "📊 COMPARISON (leader marked with 🏆)")
+	fmt.Fprintln(f.out, strings.Repeat("-", 80))
+
+	header := append([]string{"Metric"}, comparison.Users...)
+	table := tablewriter.NewWriter(f.out)
+	table.Header(header)
+	table.Options(
+		tablewriter.WithAlignment(tw.MakeAlign(len(header), tw.AlignLeft)),
+	)
+
+	for _, metric := range comparison.Metrics {
+		row := []string{metric.Name}
+		for i, value := range metric.Values {
+			cell := formatMetricValue(metric.Unit, value)
+			if comparison.Users[i] == metric.Leader {
+				cell = leader.Sprintf("%s 🏆", cell)
+			}
+			row = append(row, cell)
+		}
+		_ = table.Append(row)
+	}
+
+	row := []string{"Top Language"}
+	for _, lang := range comparison.TopLanguages {
+		if lang == "" {
+			lang = "N/A"
+		}
+		row = append(row, lang)
+	}
+	_ = table.Append(row)
+
+	_ = table.Render()
+
+	if len(comparison.Failed) > 0 {
+		var users []string
+		for user := range comparison.Failed {
+			users = append(users, user)
+		}
+		sort.Strings(users)
+
+		fmt.Fprintln(f.out)
+		for _, user := range users {
+			_, _ = yellow.Fprintf(f.out, "⚠️  Skipped @%s: %s\n", user, comparison.Failed[user])
+		}
+	}
+
+	fmt.Fprintln(f.out)
+	_, _ = blue.Fprintln(f.out, strings.Repeat("-", 80))
+	_, _ = blue.Fprintf(f.out, "Generated at: %s\n", time.Now().Format("2006-01-02 15:04:05 MST"))
+	_, _ = blue.Fprintln(f.out, strings.Repeat("=", 80))
+	fmt.Fprintln(f.out)
+
+	return nil
+}
+
+func formatMetricValue(unit string, value float64) string {
+	switch unit {
+	case github.MetricUnitHours:
+		if value == 0 {
+			return "N/A"
+		}
+		return formatDuration(time.Duration(value * float64(time.Hour)))
+	default:
+		return fmt.Sprintf("%.0f", value)
+	}
+}
@@ -0,0 +1,217 @@
+package display
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github-stats/internal/snapshot"
+
+	"github.com/fatih/color"
+	"github.com/olekukonko/tablewriter"
+	"github.com/olekukonko/tablewriter/tw"
+)
+
+type diffRow struct {
+	label  string
+	change snapshot.Change
+}
+
+func (f *Formatter) DisplayDiff(diff *snapshot.StatsDiff) error {
+	switch f.format {
+	case "json":
+		encoder := json.NewEncoder(f.out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(diff)
+	case "table":
+		return f.displayDiffTable(diff)
+	case "markdown":
+		return f.displayDiffMarkdown(diff)
+	default:
+		return fmt.Errorf("unsupported format: %s", f.format)
+	}
+}
+
+func diffRows(diff *snapshot.StatsDiff) []diffRow {
+	return []diffRow{
+		{"Followers", diff.Followers},
+		{"Total Stars", diff.TotalStars},
+		{"PRs Merged", diff.PRsMerged},
+		{"Reviews", diff.Reviews},
+		{"Current Streak", diff.CurrentStreak},
+		{"Longest Streak", diff.MaxStreak},
+	}
+}
+
+func (f *Formatter) displayDiffTable(diff *snapshot.StatsDiff) error {
+	cyan := color.New(color.FgCyan, color.Bold)
+	green := color.New(color.FgGreen)
+	blue := color.New(color.FgBlue)
+
+	_, _ = cyan.Fprintln(f.out, "\n"+strings.Repeat("=", 80))
+	_, _ = cyan.Fprintf(f.out, "  🔄 Changes for @%s\n", diff.Username)
+	_, _ = cyan.Fprintln(f.out, strings.Repeat("=", 80))
+	fmt.Fprintf(f.out, "  %s → %s\n", formatTimestamp(diff.From), formatTimestamp(diff.To))
+
+	fmt.Fprintln(f.out)
+	_, _ = green.Fprintln(f.out, "📊 SUMMARY")
+	fmt.Fprintln(f.out, strings.Repeat("-", 80))
+
+	table := tablewriter.NewWriter(f.out)
+	table.Header("Metric", "Before", "After", "Change")
+	table.Options(
+		tablewriter.WithAlignment(tw.MakeAlign(4, tw.AlignLeft)),
+	)
+	for _, row := range diffRows(diff) {
+		_ = table.Append([]string{
+			row.label,
+			fmt.Sprintf("%d", row.change.Before),
+			fmt.Sprintf("%d", row.change.After),
+			formatDelta(row.change.After, row.change.Before),
+		})
+	}
+	_ = table.Render()
+
+	if len(diff.RepoStars) > 0 {
+		fmt.Fprintln(f.out)
+		_, _ = green.Fprintln(f.out, "⭐ STARS BY REPOSITORY")
+		fmt.Fprintln(f.out, strings.Repeat("-", 80))
+
+		table = tablewriter.NewWriter(f.out)
+		table.Header("Repository", "Before", "After", "Change")
+		table.Options(
+			tablewriter.WithAlignment(tw.MakeAlign(4, tw.AlignLeft)),
+		)
+		for _, repo := range diff.RepoStars {
+			_ = table.Append([]string{
+				repoStarLabel(repo),
+				fmt.Sprintf("%d", repo.Before),
+				fmt.Sprintf("%d", repo.After),
+				fmt.Sprintf("%+d", repo.Delta),
+			})
+		}
+		_ = table.Render()
+	}
+
+	if len(diff.NewFollowers) > 0 {
+		fmt.Fprintln(f.out)
+		_, _ = green.Fprintf(f.out, "👥 NEW FOLLOWERS (%d)\n", len(diff.NewFollowers))
+		fmt.Fprintln(f.out, strings.Repeat("-", 80))
+		for _, login := range diff.NewFollowers {
+			fmt.Fprintf(f.out, "  @%s\n", login)
+		}
+	}
+
+	if len(diff.NewMergedPRs) > 0 {
+		fmt.Fprintln(f.out)
+		_, _ = green.Fprintf(f.out, "🔀 NEWLY MERGED PRS (%d)\n", len(diff.NewMergedPRs))
+		fmt.Fprintln(f.out, strings.Repeat("-", 80))
+
+		table = tablewriter.NewWriter(f.out)
+		table.Header("Repository", "PR", "Title", "Merged")
+		table.Options(
+			tablewriter.WithAlignment(tw.MakeAlign(4, tw.AlignLeft)),
+		)
+		for _, pr := range diff.NewMergedPRs {
+			_ = table.Append([]string{
+				pr.Repository,
+				fmt.Sprintf("#%d", pr.Number),
+				truncate(pr.Title, 50),
+				formatTimestamp(pr.MergedAt),
+			})
+		}
+		_ = table.Render()
+	}
+
+	if len(diff.LanguageShares) > 0 {
+		fmt.Fprintln(f.out)
+		_, _ = green.Fprintln(f.out, "💻 LANGUAGE SHARE MOVEMENTS")
+		fmt.Fprintln(f.out, strings.Repeat("-", 80))
+
+		table = tablewriter.NewWriter(f.out)
+		table.Header("Language", "Before", "After", "Change")
+		table.Options(
+			tablewriter.WithAlignment(tw.MakeAlign(4, tw.AlignLeft)),
+		)
+		for _, lang := range diff.LanguageShares {
+			_ = table.Append([]string{
+				lang.Language,
+				fmt.Sprintf("%.1f%%", lang.Before),
+				fmt.Sprintf("%.1f%%", lang.After),
+				fmt.Sprintf("%+.1f pts", lang.Delta),
+			})
+		}
+		_ = table.Render()
+	}
+
+	fmt.Fprintln(f.out)
+	_, _ = blue.Fprintln(f.out, strings.Repeat("-", 80))
+	_, _ = blue.Fprintf(f.out, "Generated at: %s\n", time.Now().Format("2006-01-02 15:04:05 MST"))
+	_, _ = blue.Fprintln(f.out, strings.Repeat("=", 80))
+	fmt.Fprintln(f.out)
+
+	return nil
+}
+
+func (f *Formatter) displayDiffMarkdown(diff *snapshot.StatsDiff) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "## Changes for @%s\n\n", diff.Username)
+	fmt.Fprintf(&b, "_%s → %s_\n\n", formatTimestamp(diff.From), formatTimestamp(diff.To))
+
+	fmt.Fprintf(&b, "| Metric | Before | After | Change |\n")
+	fmt.Fprintf(&b, "|---|---|---|---|\n")
+	for _, row := range diffRows(diff) {
+		fmt.Fprintf(&b, "| %s | %d | %d | %s |\n", row.label, row.change.Before, row.change.After,
+			formatDelta(row.change.After, row.change.Before))
+	}
+
+	if len(diff.RepoStars) > 0 {
+		fmt.Fprintf(&b, "\n### Stars by Repository\n\n")
+		for _, repo := range diff.RepoStars {
+			fmt.Fprintf(&b, "- **%s**: %+d (%d → %d)\n", repoStarLabel(repo), repo.Delta, repo.Before, repo.After)
+		}
+	}
+
+	if len(diff.NewFollowers) > 0 {
+		fmt.Fprintf(&b, "\n### New Followers\n\n")
+		for _, login := range diff.NewFollowers {
+			fmt.Fprintf(&b, "- @%s\n", login)
+		}
+	}
+
+	if len(diff.NewMergedPRs) > 0 {
+		fmt.Fprintf(&b, "\n### Newly Merged PRs\n\n")
+		for _, pr := range diff.NewMergedPRs {
+			fmt.Fprintf(&b, "- [%s#%d](%s) %s\n", pr.Repository, pr.Number, pr.URL, pr.Title)
+		}
+	}
+
+	if len(diff.LanguageShares) > 0 {
+		fmt.Fprintf(&b, "\n### Language Share\n\n")
+		for _, lang := range diff.LanguageShares {
+			fmt.Fprintf(&b, "- **%s**: %+.1f pts (%.1f%% → %.1f%%)\n", lang.Language, lang.Delta, lang.Before, lang.After)
+		}
+	}
+
+	_, err := fmt.Fprint(f.out, b.String())
+	return err
+}
+
+func repoStarLabel(repo snapshot.RepoStarChange) string {
+	switch {
+	case repo.Removed:
+		return repo.Repository + " (removed or renamed)"
+	case repo.Added:
+		return repo.Repository + " (new)"
+	}
+	return repo.Repository
+}
+
+func formatTimestamp(t time.Time) string {
+	if t.IsZero() {
+		return "N/A"
+	}
+	return t.Local().Format("2006-01-02 15:04")
+}
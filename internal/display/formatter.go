@@ -3,6 +3,7 @@ package display
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 	"time"
@@ -16,10 +17,11 @@ import (
 
 type Formatter struct {
 	format string
+	out    io.Writer
 }
 
-func NewFormatter(format string) *Formatter {
-	return &Formatter{format: format}
+func NewFormatter(format string, out io.Writer) *Formatter {
+	return &Formatter{format: format, out: out}
 }
 
 func (f *Formatter) Display(stats *github.UserStats) error {
@@ -34,7 +36,7 @@ func (f *Formatter) Display(stats *github.UserStats) error {
 }
 
 func (f *Formatter) displayJSON(stats *github.UserStats) error {
-	encoder := json.NewEncoder(os.Stdout)
+	encoder := json.NewEncoder(f.out)
 	encoder.SetIndent("", "  ")
 	return encoder.Encode(stats)
 }
@@ -44,15 +46,15 @@ func (f *Formatter) displayTable(stats *github.UserStats) error {
 	green := color.New(color.FgGreen)
 	blue := color.New(color.FgBlue)
 
-	_, _ = cyan.Println("\n" + strings.Repeat("=", 80))
-	_, _ = cyan.Printf("  GitHub Statistics for @%s\n", stats.Username)
-	_, _ = cyan.Println(strings.Repeat("=", 80))
+	_, _ = cyan.Fprintln(f.out, "\n"+strings.Repeat("=", 80))
+	_, _ = cyan.Fprintf(f.out, "  GitHub Statistics for @%s\n", stats.Username)
+	_, _ = cyan.Fprintln(f.out, strings.Repeat("=", 80))
 
-	fmt.Println()
-	_, _ = green.Println("👤 PROFILE")
-	fmt.Println(strings.Repeat("-", 80))
+	fmt.Fprintln(f.out)
+	_, _ = green.Fprintln(f.out, "👤 PROFILE")
+	fmt.Fprintln(f.out, strings.Repeat("-", 80))
 
-	table := tablewriter.NewWriter(os.Stdout)
+	table := tablewriter.NewWriter(f.out)
 	table.Header("Field", "Value")
 	table.Options(
 		tablewriter.WithAlignment(tw.MakeAlign(2, tw.AlignLeft)),
@@ -81,11 +83,11 @@ func (f *Formatter) displayTable(stats *github.UserStats) error {
 
 	_ = table.Render()
 
-	fmt.Println()
-	_, _ = green.Println("📚 REPOSITORY STATISTICS")
-	fmt.Println(strings.Repeat("-", 80))
+	fmt.Fprintln(f.out)
+	_, _ = green.Fprintln(f.out, "📚 REPOSITORY STATISTICS")
+	fmt.Fprintln(f.out, strings.Repeat("-", 80))
 
-	table = tablewriter.NewWriter(os.Stdout)
+	table = tablewriter.NewWriter(f.out)
 	table.Header("Metric", "Value")
 	table.Options(
 		tablewriter.WithAlignment(tw.MakeAlign(2, tw.AlignLeft)),
@@ -95,14 +97,22 @@ func (f *Formatter) displayTable(stats *github.UserStats) error {
 	_ = table.Append([]string{"Public Gists", fmt.Sprintf("%d", stats.PublicGists)})
 	_ = table.Append([]string{"Total Stars Received", fmt.Sprintf("%d ⭐", stats.TotalStars)})
 	_ = table.Append([]string{"Total Forks Received", fmt.Sprintf("%d", stats.TotalForks)})
+	if stats.FilteredRepos > 0 {
+		_ = table.Append([]string{"Repositories Filtered Out", fmt.Sprintf("%d", stats.FilteredRepos)})
+	}
+	for _, affiliation := range []string{github.AffiliationOwner, github.AffiliationCollaborator, github.AffiliationOrganizationMember} {
+		if count := stats.ReposByAffiliation[affiliation]; count > 0 {
+			_ = table.Append([]string{"Repositories (" + affiliation + ")", fmt.Sprintf("%d", count)})
+		}
+	}
 
 	_ = table.Render()
 
-	fmt.Println()
-	_, _ = green.Println("🔥 COMMIT STREAKS")
-	fmt.Println(strings.Repeat("-", 80))
+	fmt.Fprintln(f.out)
+	_, _ = green.Fprintln(f.out, "🔥 COMMIT STREAKS")
+	fmt.Fprintln(f.out, strings.Repeat("-", 80))
 
-	table = tablewriter.NewWriter(os.Stdout)
+	table = tablewriter.NewWriter(f.out)
 	table.Header("Metric", "Value")
 	table.Options(
 		tablewriter.WithAlignment(tw.MakeAlign(2, tw.AlignLeft)),
@@ -123,15 +133,47 @@ func (f *Formatter) displayTable(stats *github.UserStats) error {
 		_ = table.Append([]string{"Max Streak Period", streakRange})
 	}
 	_ = table.Append([]string{"Total Commit Days", fmt.Sprintf("%d", stats.TotalCommitDays)})
+	if stats.ActivitySource != "" {
+		_ = table.Append([]string{"Activity Source", stats.ActivitySource})
+	}
+	if len(stats.CalendarYears) > 0 {
+		_ = table.Append([]string{"Calendar Years Fetched", formatYears(stats.CalendarYears)})
+	}
+	if len(stats.CalendarFailedYears) > 0 {
+		_ = table.Append([]string{"Calendar Years Failed", formatYears(stats.CalendarFailedYears) + " ⚠"})
+	}
 
 	_ = table.Render()
 
+	if stats.ActivityProvenance != nil && len(stats.ActivityProvenance.Days) > 0 {
+		fmt.Fprintln(f.out)
+		_, _ = green.Fprintln(f.out, "🧭 ACTIVITY SOURCES")
+		fmt.Fprintln(f.out, strings.Repeat("-", 80))
+
+		table = tablewriter.NewWriter(f.out)
+		table.Header("Source", "Active Days", "Only in This Source")
+		table.Options(
+			tablewriter.WithAlignment(tw.MakeAlign(3, tw.AlignLeft)),
+		)
+
+		for _, source := range []string{github.ActivitySourceCalendar, github.ActivitySourceEvents, github.ActivitySourceCommits} {
+			_ = table.Append([]string{
+				source,
+				fmt.Sprintf("%d", stats.ActivityProvenance.SourceDays[source]),
+				fmt.Sprintf("%d", stats.ActivityProvenance.UniqueDays[source]),
+			})
+		}
+		_ = table.Append([]string{"merged", fmt.Sprintf("%d", len(stats.ActivityProvenance.Days)), ""})
+
+		_ = table.Render()
+	}
+
 	if stats.MostActiveDay != "" || stats.MostActiveHour > 0 {
-		fmt.Println()
-		_, _ = green.Println("📊 ACTIVITY PATTERNS")
-		fmt.Println(strings.Repeat("-", 80))
+		fmt.Fprintln(f.out)
+		_, _ = green.Fprintln(f.out, "📊 ACTIVITY PATTERNS")
+		fmt.Fprintln(f.out, strings.Repeat("-", 80))
 
-		table = tablewriter.NewWriter(os.Stdout)
+		table = tablewriter.NewWriter(f.out)
 		table.Header("Metric", "Value")
 		table.Options(
 			tablewriter.WithAlignment(tw.MakeAlign(2, tw.AlignLeft)),
@@ -149,14 +191,23 @@ func (f *Formatter) displayTable(stats *github.UserStats) error {
 	}
 
 	if len(stats.Languages) > 0 {
-		fmt.Println()
-		_, _ = green.Println("💻 LANGUAGE STATISTICS")
-		fmt.Println(strings.Repeat("-", 80))
+		fmt.Fprintln(f.out)
+		byRepos := stats.LanguageWeighting == github.LanguageWeightRepos
+		if byRepos {
+			_, _ = green.Fprintln(f.out, "💻 LANGUAGE STATISTICS (weighted by repository count)")
+		} else {
+			_, _ = green.Fprintln(f.out, "💻 LANGUAGE STATISTICS (weighted by bytes)")
+		}
+		fmt.Fprintln(f.out, strings.Repeat("-", 80))
 
 		langStats := github.GetLanguageStats(stats.Languages)
 
-		table = tablewriter.NewWriter(os.Stdout)
-		table.Header("Language", "Bytes", "Percentage")
+		table = tablewriter.NewWriter(f.out)
+		if byRepos {
+			table.Header("Language", "Repositories", "Percentage")
+		} else {
+			table.Header("Language", "Bytes", "Percentage")
+		}
 		table.Options(
 			tablewriter.WithAlignment(tw.MakeAlign(3, tw.AlignLeft)),
 		)
@@ -168,9 +219,13 @@ func (f *Formatter) displayTable(stats *github.UserStats) error {
 
 		for i := 0; i < count; i++ {
 			lang := langStats.TopLanguages[i]
+			amount := formatBytes(lang.Bytes)
+			if byRepos {
+				amount = fmt.Sprintf("%d", lang.Bytes)
+			}
 			_ = table.Append([]string{
 				lang.Name,
-				formatBytes(lang.Bytes),
+				amount,
 				fmt.Sprintf("%.1f%%", lang.Percentage),
 			})
 		}
@@ -178,15 +233,53 @@ func (f *Formatter) displayTable(stats *github.UserStats) error {
 		_ = table.Render()
 	}
 
+	if stats.LanguageTimeline != nil && len(stats.LanguageTimeline.Years) > 1 {
+		fmt.Fprintln(f.out)
+		_, _ = green.Fprintf(f.out, "📆 LANGUAGE TIMELINE (by %s year)\n", stats.LanguageTimeline.BucketBy)
+		fmt.Fprintln(f.out, strings.Repeat("-", 80))
+
+		timeline := stats.LanguageTimeline
+		header := append([]string{"Year"}, timeline.Languages...)
+		table = tablewriter.NewWriter(f.out)
+		table.Header(header)
+		table.Options(
+			tablewriter.WithAlignment(tw.MakeAlign(len(header), tw.AlignLeft)),
+		)
+
+		for i, year := range timeline.Years {
+			row := []string{fmt.Sprintf("%d", year)}
+			for _, series := range timeline.Series {
+				share := series.Points[i].Share
+				if share == 0 {
+					row = append(row, "-")
+				} else {
+					row = append(row, fmt.Sprintf("%.0f%%", share))
+				}
+			}
+			_ = table.Append(row)
+		}
+
+		_ = table.Render()
+	}
+
 	if len(stats.TopRepositories) > 0 {
-		fmt.Println()
-		_, _ = green.Println("🌟 TOP REPOSITORIES (by stars)")
-		fmt.Println(strings.Repeat("-", 80))
+		fmt.Fprintln(f.out)
+		topBy := stats.TopRepositoriesBy
+		if topBy == "" {
+			topBy = github.TopByStars
+		}
+		_, _ = green.Fprintf(f.out, "🌟 TOP REPOSITORIES (by %s)\n", topBy)
+		fmt.Fprintln(f.out, strings.Repeat("-", 80))
 
-		table = tablewriter.NewWriter(os.Stdout)
-		table.Header("Repository", "Stars", "Forks", "Language")
+		table = tablewriter.NewWriter(f.out)
+		header := []string{"Repository", "Stars", "Forks", "Language", "Pushed", "Description"}
+		if topBy == github.TopByCommits {
+			header = append(header, "Commits")
+		}
+		header = append(header, "Affiliation")
+		table.Header(header)
 		table.Options(
-			tablewriter.WithAlignment(tw.MakeAlign(4, tw.AlignLeft)),
+			tablewriter.WithAlignment(tw.MakeAlign(len(header), tw.AlignLeft)),
 		)
 
 		for _, repo := range stats.TopRepositories {
@@ -194,23 +287,98 @@ func (f *Formatter) displayTable(stats *github.UserStats) error {
 			if lang == "" {
 				lang = "N/A"
 			}
-			_ = table.Append([]string{
-				repo.Name,
+			name := repo.Name
+			if repo.Affiliation != github.AffiliationOwner && repo.FullName != "" {
+				name = repo.FullName
+			}
+			if repo.Archived {
+				name += " (archived)"
+			}
+			row := []string{
+				name,
 				fmt.Sprintf("%d ⭐", repo.Stars),
 				fmt.Sprintf("%d", repo.Forks),
 				lang,
+				formatAgo(repo.PushedAt),
+				truncate(repo.Description, 40),
+			}
+			if topBy == github.TopByCommits {
+				row = append(row, fmt.Sprintf("%d", repo.Commits))
+			}
+			row = append(row, repo.Affiliation)
+			_ = table.Append(row)
+		}
+
+		_ = table.Render()
+	}
+
+	if len(stats.ExternalContributions) > 0 {
+		fmt.Fprintln(f.out)
+		_, _ = green.Fprintln(f.out, "🌍 OPEN SOURCE CONTRIBUTIONS (by impact)")
+		fmt.Fprintln(f.out, strings.Repeat("-", 80))
+		fmt.Fprintf(f.out, "  Commits: %d to own repositories, %d to other repositories\n",
+			stats.OwnRepoCommits, stats.OtherRepoCommits)
+		if stats.UnattributedCommits > 0 {
+			fmt.Fprintf(f.out, "  (%d more commits in repositories beyond GitHub's per-year breakdown limit)\n", stats.UnattributedCommits)
+		}
+
+		table = tablewriter.NewWriter(f.out)
+		table.Header("Repository", "Stars", "Merged PRs", "Commits", "Reviews", "First", "Last")
+		table.Options(
+			tablewriter.WithAlignment(tw.MakeAlign(7, tw.AlignLeft)),
+		)
+		for i, contribution := range stats.ExternalContributions {
+			if i >= 10 {
+				break
+			}
+			_ = table.Append([]string{
+				contribution.Repository,
+				fmt.Sprintf("%d ⭐", contribution.Stars),
+				fmt.Sprintf("%d", contribution.MergedPRs),
+				fmt.Sprintf("%d", contribution.Commits),
+				fmt.Sprintf("%d", contribution.Reviews),
+				formatDate(contribution.FirstContribution),
+				formatDate(contribution.LastContribution),
 			})
 		}
+		_ = table.Render()
+
+		if len(stats.ExternalContributions) > 10 {
+			fmt.Fprintf(f.out, "  ... and %d more repositories\n", len(stats.ExternalContributions)-10)
+		}
+	}
 
+	if len(stats.Collaborators) > 0 {
+		fmt.Fprintln(f.out)
+		_, _ = green.Fprintln(f.out, "🤝 TOP COLLABORATORS")
+		fmt.Fprintln(f.out, strings.Repeat("-", 80))
+
+		table = tablewriter.NewWriter(f.out)
+		table.Header("Collaborator", "Reviewed Your PRs", "You Reviewed", "Co-authored", "Shared Repos")
+		table.Options(
+			tablewriter.WithAlignment(tw.MakeAlign(5, tw.AlignLeft)),
+		)
+		for i, collaborator := range stats.Collaborators {
+			if i >= 10 {
+				break
+			}
+			_ = table.Append([]string{
+				"@" + collaborator.Login,
+				fmt.Sprintf("%d", collaborator.ReviewedByThem),
+				fmt.Sprintf("%d", collaborator.ReviewedByUser),
+				fmt.Sprintf("%d", collaborator.CoAuthored),
+				fmt.Sprintf("%d", len(collaborator.SharedRepos)),
+			})
+		}
 		_ = table.Render()
 	}
 
 	if stats.PRStats != nil && stats.PRStats.Total > 0 {
-		fmt.Println()
-		_, _ = green.Println("🔀 PULL REQUEST STATISTICS")
-		fmt.Println(strings.Repeat("-", 80))
+		fmt.Fprintln(f.out)
+		_, _ = green.Fprintln(f.out, "🔀 PULL REQUEST STATISTICS")
+		fmt.Fprintln(f.out, strings.Repeat("-", 80))
 
-		table = tablewriter.NewWriter(os.Stdout)
+		table = tablewriter.NewWriter(f.out)
 		table.Header("Metric", "Value")
 		table.Options(
 			tablewriter.WithAlignment(tw.MakeAlign(2, tw.AlignLeft)),
@@ -223,24 +391,63 @@ func (f *Formatter) displayTable(stats *github.UserStats) error {
 		if stats.PRStats.AvgMergeTime > 0 {
 			_ = table.Append([]string{"Avg Time to Merge", formatDuration(stats.PRStats.AvgMergeTime)})
 		}
+		appendDurationRows(table, "Time to Merge", stats.PRStats.MergeTime)
+		_ = table.Append([]string{"Avg Lines Changed", fmt.Sprintf("+%.0f / -%.0f", stats.PRStats.AvgAdditions, stats.PRStats.AvgDeletions)})
+		_ = table.Append([]string{"Avg Files Changed", fmt.Sprintf("%.1f", stats.PRStats.AvgChangedFiles)})
+		_ = table.Append([]string{"Avg Commits per PR", fmt.Sprintf("%.1f", stats.PRStats.AvgCommits)})
+		if stats.PRStats.TimeToFirstReview.Count > 0 {
+			_ = table.Append([]string{"Median Time to First Review", formatDuration(stats.PRStats.TimeToFirstReview.Median)})
+			_ = table.Append([]string{"Avg Review Rounds", fmt.Sprintf("%.1f", stats.PRStats.AvgReviewRounds)})
+			_ = table.Append([]string{"Avg Reviewers", fmt.Sprintf("%.1f", stats.PRStats.AvgReviewers)})
+		}
 
 		_ = table.Render()
 
+		f.displayHistogram("Time to Merge", stats.PRStats.MergeTimeHistogram, "PRs")
+
+		if len(stats.PRStats.SizeBreakdown) > 0 {
+			fmt.Fprintln(f.out)
+			fmt.Fprintln(f.out, "  PR Size Breakdown (lines changed):")
+
+			table = tablewriter.NewWriter(f.out)
+			table.Header("Size", "PRs", "Merged", "Median Time to Merge")
+			table.Options(
+				tablewriter.WithAlignment(tw.MakeAlign(4, tw.AlignLeft)),
+			)
+
+			for _, size := range stats.PRStats.SizeBreakdown {
+				mergeTime := "-"
+				if size.Merged > 0 {
+					mergeTime = formatDuration(size.MedianMergeTime)
+				}
+				_ = table.Append([]string{
+					size.Size,
+					fmt.Sprintf("%d", size.Count),
+					fmt.Sprintf("%d", size.Merged),
+					mergeTime,
+				})
+			}
+
+			_ = table.Render()
+
+			fmt.Fprintf(f.out, "  Size vs. merge time correlation: %.2f\n", stats.PRStats.SizeMergeCorrelation)
+		}
+
 		if len(stats.PRStats.TopRepos) > 0 {
-			fmt.Println()
-			fmt.Println("  Top Repositories by PR Count:")
+			fmt.Fprintln(f.out)
+			fmt.Fprintln(f.out, "  Top Repositories by PR Count:")
 			for _, repo := range stats.PRStats.TopRepos {
-				fmt.Printf("    - %s: %d PRs\n", repo.RepoName, repo.Count)
+				fmt.Fprintf(f.out, "    - %s: %d PRs\n", repo.RepoName, repo.Count)
 			}
 		}
 	}
 
 	if stats.IssueStats != nil && stats.IssueStats.Total > 0 {
-		fmt.Println()
-		_, _ = green.Println("📋 ISSUE STATISTICS")
-		fmt.Println(strings.Repeat("-", 80))
+		fmt.Fprintln(f.out)
+		_, _ = green.Fprintln(f.out, "📋 ISSUE STATISTICS")
+		fmt.Fprintln(f.out, strings.Repeat("-", 80))
 
-		table = tablewriter.NewWriter(os.Stdout)
+		table = tablewriter.NewWriter(f.out)
 		table.Header("Metric", "Value")
 		table.Options(
 			tablewriter.WithAlignment(tw.MakeAlign(2, tw.AlignLeft)),
@@ -252,48 +459,166 @@ func (f *Formatter) displayTable(stats *github.UserStats) error {
 		if stats.IssueStats.AvgCloseTime > 0 {
 			_ = table.Append([]string{"Avg Time to Close", formatDuration(stats.IssueStats.AvgCloseTime)})
 		}
+		appendDurationRows(table, "Time to Close", stats.IssueStats.CloseTime)
+		if stats.IssueStats.Closed > 0 {
+			_ = table.Append([]string{"Closed as Completed", fmt.Sprintf("%d", stats.IssueStats.Completed)})
+			_ = table.Append([]string{"Closed as Not Planned", fmt.Sprintf("%d", stats.IssueStats.NotPlanned)})
+			_ = table.Append([]string{"Closed as Duplicate", fmt.Sprintf("%d", stats.IssueStats.Duplicate)})
+			_ = table.Append([]string{"Closed by Author", fmt.Sprintf("%d", stats.IssueStats.ClosedByAuthor)})
+			_ = table.Append([]string{"Closed by Maintainer", fmt.Sprintf("%d", stats.IssueStats.ClosedByOthers)})
+		}
+		_ = table.Append([]string{"Issues with Linked PRs", fmt.Sprintf("%d (%d PRs)", stats.IssueStats.IssuesWithLinkedPRs, stats.IssueStats.LinkedPRs)})
 
 		_ = table.Render()
+
+		f.displayHistogram("Time to Close", stats.IssueStats.CloseTimeHistogram, "issues")
+
+		if len(stats.IssueStats.TopRepos) > 0 {
+			fmt.Fprintln(f.out)
+			fmt.Fprintln(f.out, "  Top Repositories by Issue Count:")
+			for _, repo := range stats.IssueStats.TopRepos {
+				fmt.Fprintf(f.out, "    - %s: %d issues\n", repo.RepoName, repo.Count)
+			}
+		}
+
+		if len(stats.IssueStats.TopLabels) > 0 {
+			fmt.Fprintln(f.out)
+			fmt.Fprintln(f.out, "  Labels:")
+			for _, label := range stats.IssueStats.TopLabels {
+				fmt.Fprintf(f.out, "    - %s: %d issues\n", label.Name, label.Count)
+			}
+		}
 	}
 
 	if stats.ReviewStats != nil && stats.ReviewStats.Total > 0 {
-		fmt.Println()
-		_, _ = green.Println("👀 CODE REVIEW STATISTICS")
-		fmt.Println(strings.Repeat("-", 80))
+		fmt.Fprintln(f.out)
+		_, _ = green.Fprintln(f.out, "👀 CODE REVIEW STATISTICS")
+		fmt.Fprintln(f.out, strings.Repeat("-", 80))
 
-		table = tablewriter.NewWriter(os.Stdout)
+		table = tablewriter.NewWriter(f.out)
 		table.Header("Metric", "Value")
 		table.Options(
 			tablewriter.WithAlignment(tw.MakeAlign(2, tw.AlignLeft)),
 		)
 
 		_ = table.Append([]string{"Total Reviews", fmt.Sprintf("%d", stats.ReviewStats.Total)})
+		_ = table.Append([]string{"Approved", fmt.Sprintf("%d ✓", stats.ReviewStats.Approved)})
+		_ = table.Append([]string{"Changes Requested", fmt.Sprintf("%d", stats.ReviewStats.ChangesRequested)})
+		_ = table.Append([]string{"Commented", fmt.Sprintf("%d", stats.ReviewStats.Commented)})
+		if stats.ReviewStats.Dismissed > 0 {
+			_ = table.Append([]string{"Dismissed", fmt.Sprintf("%d", stats.ReviewStats.Dismissed)})
+		}
+		_ = table.Append([]string{"Approval Ratio", fmt.Sprintf("%.1f%%", stats.ReviewStats.ApprovalRatio*100)})
+		if stats.ReviewStats.Turnaround.Count > 0 {
+			_ = table.Append([]string{"Median Review Turnaround", formatDuration(stats.ReviewStats.Turnaround.Median)})
+			_ = table.Append([]string{"P90 Review Turnaround", formatDuration(stats.ReviewStats.Turnaround.P90)})
+		}
+		_ = table.Append([]string{"Review Comments", fmt.Sprintf("%d (%.1f per review)", stats.ReviewStats.TotalComments, stats.ReviewStats.AvgComments)})
 
 		_ = table.Render()
 
 		if len(stats.ReviewStats.TopRepos) > 0 {
-			fmt.Println()
-			fmt.Println("  Top Repositories by Review Count:")
+			fmt.Fprintln(f.out)
+			fmt.Fprintln(f.out, "  Top Repositories by Review Count:")
 			for _, repo := range stats.ReviewStats.TopRepos {
-				fmt.Printf("    - %s: %d reviews\n", repo.RepoName, repo.Count)
+				fmt.Fprintf(f.out, "    - %s: %d reviews\n", repo.RepoName, repo.Count)
+			}
+		}
+
+		if len(stats.ReviewStats.ByYear) > 0 {
+			maxCount := 0
+			for _, year := range stats.ReviewStats.ByYear {
+				if year.Count > maxCount {
+					maxCount = year.Count
+				}
+			}
+
+			fmt.Fprintln(f.out)
+			fmt.Fprintln(f.out, "  Reviews by Year:")
+			for _, year := range stats.ReviewStats.ByYear {
+				barLen := 0
+				if maxCount > 0 {
+					barLen = year.Count * 40 / maxCount
+				}
+				fmt.Fprintf(f.out, "    %d %s %d\n", year.Year, strings.Repeat("█", barLen), year.Count)
+			}
+		}
+
+		if len(stats.ReviewStats.TopReviewees) > 0 {
+			fmt.Fprintln(f.out)
+			fmt.Fprintln(f.out, "  Most Reviewed Authors:")
+			for _, user := range stats.ReviewStats.TopReviewees {
+				fmt.Fprintf(f.out, "    - @%s: %d reviews\n", user.Login, user.Count)
 			}
 		}
 	}
 
-	fmt.Println()
-	_, _ = blue.Println(strings.Repeat("-", 80))
-	_, _ = blue.Printf("Generated at: %s\n", time.Now().Format("2006-01-02 15:04:05 MST"))
-	_, _ = blue.Println(strings.Repeat("=", 80))
-	fmt.Println()
+	fmt.Fprintln(f.out)
+	_, _ = blue.Fprintln(f.out, strings.Repeat("-", 80))
+	_, _ = blue.Fprintf(f.out, "Generated at: %s\n", time.Now().Format("2006-01-02 15:04:05 MST"))
+	_, _ = blue.Fprintln(f.out, strings.Repeat("=", 80))
+	fmt.Fprintln(f.out)
 
 	return nil
 }
 
+func appendDurationRows(table *tablewriter.Table, label string, ds github.DurationStats) {
+	if ds.Count == 0 {
+		return
+	}
+	_ = table.Append([]string{"Median " + label, formatDuration(ds.Median)})
+	_ = table.Append([]string{"P75 " + label, formatDuration(ds.P75)})
+	_ = table.Append([]string{"P90 " + label, formatDuration(ds.P90)})
+	_ = table.Append([]string{"Fastest / Slowest", fmt.Sprintf("%s / %s", formatDuration(ds.Min), formatDuration(ds.Max))})
+}
+
+func (f *Formatter) displayHistogram(title string, buckets []github.DurationBucket, unit string) {
+	maxCount := 0
+	for _, bucket := range buckets {
+		if bucket.Count > maxCount {
+			maxCount = bucket.Count
+		}
+	}
+	if maxCount == 0 {
+		return
+	}
+
+	fmt.Fprintln(f.out)
+	fmt.Fprintf(f.out, "  %s Distribution:\n", title)
+	for _, bucket := range buckets {
+		barLen := bucket.Count * 40 / maxCount
+		fmt.Fprintf(f.out, "    %-7s %s %d %s\n", bucket.Label, strings.Repeat("█", barLen), bucket.Count, unit)
+	}
+}
+
+func formatYears(years []int) string {
+	if len(years) == 0 {
+		return ""
+	}
+
+	var parts []string
+	start := 0
+	for i := 1; i <= len(years); i++ {
+		if i < len(years) && years[i] == years[i-1]+1 {
+			continue
+		}
+		if start == i-1 {
+			parts = append(parts, fmt.Sprintf("%d", years[start]))
+		} else {
+			parts = append(parts, fmt.Sprintf("%d-%d", years[start], years[i-1]))
+		}
+		start = i
+	}
+
+	return fmt.Sprintf("%s (%d years)", strings.Join(parts, ", "), len(years))
+}
+
 func truncate(s string, maxLen int) string {
-	if len(s) <= maxLen {
+	runes := []rune(s)
+	if len(runes) <= maxLen {
 		return s
 	}
-	return s[:maxLen-3] + "..."
+	return string(runes[:maxLen-3]) + "..."
 }
 
 func formatBytes(bytes int64) string {
@@ -338,22 +663,47 @@ func formatDuration(d time.Duration) string {
 	}
 }
 
+func formatDate(t time.Time) string {
+	if t.IsZero() {
+		return "N/A"
+	}
+	return t.Format("2006-01-02")
+}
+
+func formatAgo(t time.Time) string {
+	if t.IsZero() {
+		return "N/A"
+	}
+	d := time.Since(t)
+	if d < time.Minute {
+		return "just now"
+	}
+	days := int(d.Hours() / 24)
+	switch {
+	case days >= 365:
+		return fmt.Sprintf("%dy ago", days/365)
+	case days >= 30:
+		return fmt.Sprintf("%dmo ago", days/30)
+	}
+	return formatDuration(d) + " ago"
+}
+
 func DisplayProgress(message string) {
 	cyan := color.New(color.FgCyan)
-	_, _ = cyan.Printf("⏳ %s...\n", message)
+	_, _ = cyan.Fprintf(os.Stderr, "⏳ %s...\n", message)
 }
 
 func DisplaySuccess(message string) {
 	green := color.New(color.FgGreen)
-	_, _ = green.Printf("✓ %s\n", message)
+	_, _ = green.Fprintf(os.Stderr, "✓ %s\n", message)
 }
 
 func DisplayWarning(message string) {
 	yellow := color.New(color.FgYellow)
-	_, _ = yellow.Printf("⚠ %s\n", message)
+	_, _ = yellow.Fprintf(os.Stderr, "⚠ %s\n", message)
 }
 
 func DisplayError(message string) {
 	red := color.New(color.FgRed, color.Bold)
-	_, _ = red.Printf("✗ %s\n", message)
+	_, _ = red.Fprintf(os.Stderr, "✗ %s\n", message)
 }
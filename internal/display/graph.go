@@ -0,0 +1,132 @@
+package display
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"github-stats/internal/github"
+)
+
+type graphNode struct {
+	ID    string `json:"id"`
+	Label string `json:"label"`
+	Root  bool   `json:"root,omitempty"`
+}
+
+type graphEdge struct {
+	Source         string   `json:"source"`
+	Target         string   `json:"target"`
+	Weight         int      `json:"weight"`
+	ReviewedByThem int      `json:"reviewedByThem"`
+	ReviewedByUser int      `json:"reviewedByUser"`
+	CoAuthored     int      `json:"coAuthored"`
+	SharedRepos    []string `json:"sharedRepos"`
+}
+
+type graphDocument struct {
+	Nodes []graphNode `json:"nodes"`
+	Edges []graphEdge `json:"edges"`
+}
+
+func WriteGraph(w io.Writer, format string, stats *github.UserStats) error {
+	graph := buildGraph(stats)
+
+	switch format {
+	case "json":
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(graph)
+	case "dot":
+		return writeDOT(w, graph)
+	case "graphml":
+		return writeGraphML(w, graph)
+	default:
+		return fmt.Errorf("unsupported graph format: %s", format)
+	}
+}
+
+func buildGraph(stats *github.UserStats) graphDocument {
+	graph := graphDocument{
+		Nodes: []graphNode{{ID: stats.Username, Label: stats.Username, Root: true}},
+		Edges: make([]graphEdge, 0, len(stats.Collaborators)),
+	}
+
+	for _, collaborator := range stats.Collaborators {
+		graph.Nodes = append(graph.Nodes, graphNode{ID: collaborator.Login, Label: collaborator.Login})
+		graph.Edges = append(graph.Edges, graphEdge{
+			Source:         stats.Username,
+			Target:         collaborator.Login,
+			Weight:         collaborator.Score,
+			ReviewedByThem: collaborator.ReviewedByThem,
+			ReviewedByUser: collaborator.ReviewedByUser,
+			CoAuthored:     collaborator.CoAuthored,
+			SharedRepos:    collaborator.SharedRepos,
+		})
+	}
+
+	return graph
+}
+
+func writeDOT(w io.Writer, graph graphDocument) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "graph collaboration {\n")
+	for _, node := range graph.Nodes {
+		if node.Root {
+			fmt.Fprintf(&b, "  %q [label=%q, shape=doublecircle];\n", node.ID, node.Label)
+		} else {
+			fmt.Fprintf(&b, "  %q [label=%q];\n", node.ID, node.Label)
+		}
+	}
+	for _, edge := range graph.Edges {
+		fmt.Fprintf(&b, "  %q -- %q [weight=%d, penwidth=%d, reviewed_by_them=%d, reviewed_by_user=%d, co_authored=%d, shared_repos=%q];\n",
+			edge.Source, edge.Target, edge.Weight, 1+edge.Weight/5,
+			edge.ReviewedByThem, edge.ReviewedByUser, edge.CoAuthored, strings.Join(edge.SharedRepos, ","))
+	}
+	fmt.Fprintf(&b, "}\n")
+
+	_, err := fmt.Fprint(w, b.String())
+	return err
+}
+
+func writeGraphML(w io.Writer, graph graphDocument) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%s", xml.Header)
+	fmt.Fprintf(&b, `<graphml xmlns="http://graphml.graphdrawing.org/xmlns">`+"\n")
+	fmt.Fprintf(&b, `  <key id="label" for="node" attr.name="label" attr.type="string"/>`+"\n")
+	fmt.Fprintf(&b, `  <key id="weight" for="edge" attr.name="weight" attr.type="int"/>`+"\n")
+	fmt.Fprintf(&b, `  <key id="reviewedByThem" for="edge" attr.name="reviewedByThem" attr.type="int"/>`+"\n")
+	fmt.Fprintf(&b, `  <key id="reviewedByUser" for="edge" attr.name="reviewedByUser" attr.type="int"/>`+"\n")
+	fmt.Fprintf(&b, `  <key id="coAuthored" for="edge" attr.name="coAuthored" attr.type="int"/>`+"\n")
+	fmt.Fprintf(&b, `  <key id="sharedRepos" for="edge" attr.name="sharedRepos" attr.type="string"/>`+"\n")
+	fmt.Fprintf(&b, `  <graph id="collaboration" edgedefault="undirected">`+"\n")
+
+	for _, node := range graph.Nodes {
+		fmt.Fprintf(&b, `    <node id="%s"><data key="label">%s</data></node>`+"\n", escapeXML(node.ID), escapeXML(node.Label))
+	}
+	for i, edge := range graph.Edges {
+		fmt.Fprintf(&b, `    <edge id="e%d" source="%s" target="%s">`+"\n", i, escapeXML(edge.Source), escapeXML(edge.Target))
+		fmt.Fprintf(&b, `      <data key="weight">%d</data>`+"\n", edge.Weight)
+		fmt.Fprintf(&b, `      <data key="reviewedByThem">%d</data>`+"\n", edge.ReviewedByThem)
+		fmt.Fprintf(&b, `      <data key="reviewedByUser">%d</data>`+"\n", edge.ReviewedByUser)
+		fmt.Fprintf(&b, `      <data key="coAuthored">%d</data>`+"\n", edge.CoAuthored)
+		fmt.Fprintf(&b, `      <data key="sharedRepos">%s</data>`+"\n", escapeXML(strings.Join(edge.SharedRepos, ",")))
+		fmt.Fprintf(&b, "    </edge>\n")
+	}
+
+	fmt.Fprintf(&b, "  </graph>\n")
+	fmt.Fprintf(&b, "</graphml>\n")
+
+	_, err := fmt.Fprint(w, b.String())
+	return err
+}
+
+func escapeXML(s string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
@@ -0,0 +1,108 @@
+package display
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github-stats/internal/snapshot"
+
+	"github.com/fatih/color"
+	"github.com/olekukonko/tablewriter"
+	"github.com/olekukonko/tablewriter/tw"
+)
+
+const sparklineWidth = 30
+
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+func (f *Formatter) DisplayHistory(history *snapshot.History) error {
+	switch f.format {
+	case "json":
+		encoder := json.NewEncoder(f.out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(history)
+	case "table":
+		return f.displayHistoryTable(history)
+	default:
+		return fmt.Errorf("unsupported format: %s", f.format)
+	}
+}
+
+func (f *Formatter) displayHistoryTable(history *snapshot.History) error {
+	cyan := color.New(color.FgCyan, color.Bold)
+	green := color.New(color.FgGreen)
+	blue := color.New(color.FgBlue)
+
+	_, _ = cyan.Fprintln(f.out, "\n"+strings.Repeat("=", 80))
+	_, _ = cyan.Fprintf(f.out, "  🕰️  History for @%s\n", history.Username)
+	_, _ = cyan.Fprintln(f.out, strings.Repeat("=", 80))
+
+	if history.Snapshots == 0 {
+		fmt.Fprintln(f.out)
+		fmt.Fprintln(f.out, "  No snapshots saved yet. Run github-stats without --no-save to record one.")
+		fmt.Fprintln(f.out)
+		return nil
+	}
+
+	fmt.Fprintf(f.out, "  %d snapshots from %s to %s\n", history.Snapshots,
+		history.First.Local().Format("2006-01-02 15:04"), history.Latest.Local().Format("2006-01-02 15:04"))
+
+	fmt.Fprintln(f.out)
+	_, _ = green.Fprintln(f.out, "📈 TRENDS")
+	fmt.Fprintln(f.out, strings.Repeat("-", 80))
+
+	table := tablewriter.NewWriter(f.out)
+	table.Header("Metric", "First", "Latest", "Change", "Trend")
+	table.Options(
+		tablewriter.WithAlignment(tw.MakeAlign(5, tw.AlignLeft)),
+	)
+	for _, metric := range history.Metrics {
+		_ = table.Append([]string{
+			metric.Name,
+			fmt.Sprintf("%d", metric.First),
+			fmt.Sprintf("%d", metric.Latest),
+			formatDelta(metric.Latest, metric.First),
+			sparkline(metric.Values, sparklineWidth),
+		})
+	}
+	_ = table.Render()
+
+	fmt.Fprintln(f.out)
+	_, _ = blue.Fprintln(f.out, strings.Repeat("-", 80))
+	_, _ = blue.Fprintf(f.out, "Generated at: %s\n", time.Now().Format("2006-01-02 15:04:05 MST"))
+	_, _ = blue.Fprintln(f.out, strings.Repeat("=", 80))
+	fmt.Fprintln(f.out)
+
+	return nil
+}
+
+func sparkline(values []int, width int) string {
+	if len(values) > width {
+		values = values[len(values)-width:]
+	}
+	if len(values) == 0 {
+		return ""
+	}
+
+	lowest, highest := values[0], values[0]
+	for _, v := range values {
+		if v < lowest {
+			lowest = v
+		}
+		if v > highest {
+			highest = v
+		}
+	}
+
+	var b strings.Builder
+	for _, v := range values {
+		level := 0
+		if highest > lowest {
+			level = (v - lowest) * (len(sparkBlocks) - 1) / (highest - lowest)
+		}
+		b.WriteRune(sparkBlocks[level])
+	}
+	return b.String()
+}
@@ -0,0 +1,150 @@
+package display
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github-stats/internal/github"
+
+	"github.com/fatih/color"
+	"github.com/olekukonko/tablewriter"
+	"github.com/olekukonko/tablewriter/tw"
+)
+
+func (f *Formatter) DisplayLeaderboard(board *github.Leaderboard) error {
+	switch f.format {
+	case "json":
+		encoder := json.NewEncoder(f.out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(board)
+	case "table":
+		return f.displayLeaderboardTable(board)
+	case "markdown":
+		return f.displayLeaderboardMarkdown(board)
+	default:
+		return fmt.Errorf("unsupported format: %s", f.format)
+	}
+}
+
+func (f *Formatter) displayLeaderboardTable(board *github.Leaderboard) error {
+	cyan := color.New(color.FgCyan, color.Bold)
+	green := color.New(color.FgGreen)
+	yellow := color.New(color.FgYellow)
+	blue := color.New(color.FgBlue)
+
+	_, _ = cyan.Fprintln(f.out, "\n"+strings.Repeat("=", 80))
+	_, _ = cyan.Fprintf(f.out, "  🏁 Leaderboard: %s since %s\n", board.Metric, board.Since.Format("2006-01-02"))
+	_, _ = cyan.Fprintln(f.out, strings.Repeat("=", 80))
+
+	fmt.Fprintln(f.out)
+	_, _ = green.Fprintln(f.out, "🏆 RANKING")
+	fmt.Fprintln(f.out, strings.Repeat("-", 80))
+
+	header := []string{"Rank", "User", "Score"}
+	if board.HasPrevious {
+		header = append(header, "Change")
+	}
+	table := tablewriter.NewWriter(f.out)
+	table.Header(header)
+	table.Options(
+		tablewriter.WithAlignment(tw.MakeAlign(len(header), tw.AlignLeft)),
+	)
+
+	for _, entry := range board.Entries {
+		row := []string{formatRank(entry), entry.Login, formatScore(entry.Score)}
+		if board.HasPrevious {
+			row = append(row, formatRankChange(entry))
+		}
+		_ = table.Append(row)
+	}
+	_ = table.Render()
+
+	f.displayLeaderboardFailures(board, yellow)
+
+	fmt.Fprintln(f.out)
+	_, _ = blue.Fprintln(f.out, strings.Repeat("-", 80))
+	_, _ = blue.Fprintf(f.out, "Generated at: %s\n", time.Now().Format("2006-01-02 15:04:05 MST"))
+	_, _ = blue.Fprintln(f.out, strings.Repeat("=", 80))
+	fmt.Fprintln(f.out)
+
+	return nil
+}
+
+func (f *Formatter) displayLeaderboardMarkdown(board *github.Leaderboard) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# Leaderboard: %s\n\n", board.Metric)
+	fmt.Fprintf(&b, "_Since %s_\n\n", board.Since.Format("2006-01-02"))
+
+	if board.HasPrevious {
+		fmt.Fprintf(&b, "| Rank | User | Score | Change |\n")
+		fmt.Fprintf(&b, "|---|---|---|---|\n")
+	} else {
+		fmt.Fprintf(&b, "| Rank | User | Score |\n")
+		fmt.Fprintf(&b, "|---|---|---|\n")
+	}
+
+	for _, entry := range board.Entries {
+		fmt.Fprintf(&b, "| %s | @%s | %s |", formatRank(entry), entry.Login, formatScore(entry.Score))
+		if board.HasPrevious {
+			fmt.Fprintf(&b, " %s |", formatRankChange(entry))
+		}
+		fmt.Fprintf(&b, "\n")
+	}
+
+	if len(board.Failed) > 0 {
+		fmt.Fprintf(&b, "\n_Not ranked: %s_\n", strings.Join(failedLogins(board), ", "))
+	}
+
+	_, err := fmt.Fprint(f.out, b.String())
+	return err
+}
+
+func (f *Formatter) displayLeaderboardFailures(board *github.Leaderboard, c *color.Color) {
+	if len(board.Failed) == 0 {
+		return
+	}
+	fmt.Fprintln(f.out)
+	for _, login := range failedLogins(board) {
+		_, _ = c.Fprintf(f.out, "⚠️  Not ranked @%s: %s\n", login, board.Failed[login])
+	}
+}
+
+func failedLogins(board *github.Leaderboard) []string {
+	var logins []string
+	for login := range board.Failed {
+		logins = append(logins, login)
+	}
+	sort.Strings(logins)
+	return logins
+}
+
+func formatRank(entry github.LeaderboardEntry) string {
+	if entry.Tied {
+		return fmt.Sprintf("T%d", entry.Rank)
+	}
+	return fmt.Sprintf("%d", entry.Rank)
+}
+
+func formatScore(score float64) string {
+	if score == float64(int64(score)) {
+		return fmt.Sprintf("%d", int64(score))
+	}
+	return fmt.Sprintf("%.1f", score)
+}
+
+func formatRankChange(entry github.LeaderboardEntry) string {
+	switch {
+	case entry.New:
+		return "new"
+	case entry.RankChange > 0:
+		return fmt.Sprintf("▲%d", entry.RankChange)
+	case entry.RankChange < 0:
+		return fmt.Sprintf("▼%d", -entry.RankChange)
+	default:
+		return "–"
+	}
+}
@@ -0,0 +1,158 @@
+package display
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github-stats/internal/github"
+
+	"github.com/fatih/color"
+	"github.com/olekukonko/tablewriter"
+	"github.com/olekukonko/tablewriter/tw"
+)
+
+func (f *Formatter) DisplayOrg(report *github.OrgReport) error {
+	switch f.format {
+	case "json":
+		encoder := json.NewEncoder(f.out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(report)
+	case "table":
+		return f.displayOrgTable(report)
+	case "csv":
+		return f.displayOrgCSV(report)
+	default:
+		return fmt.Errorf("unsupported format: %s", f.format)
+	}
+}
+
+func (f *Formatter) displayOrgTable(report *github.OrgReport) error {
+	cyan := color.New(color.FgCyan, color.Bold)
+	green := color.New(color.FgGreen)
+	yellow := color.New(color.FgYellow)
+	blue := color.New(color.FgBlue)
+
+	title := report.Org
+	if report.Team != "" {
+		title = fmt.Sprintf("%s/%s", report.Org, report.Team)
+	}
+
+	_, _ = cyan.Fprintln(f.out, "\n"+strings.Repeat("=", 80))
+	_, _ = cyan.Fprintf(f.out, "  🏢 Organization Dashboard for %s\n", title)
+	_, _ = cyan.Fprintln(f.out, strings.Repeat("=", 80))
+	fmt.Fprintf(f.out, "  Window: %s - %s\n", report.Since.Format("2006-01-02"), report.Until.Format("2006-01-02"))
+
+	fmt.Fprintln(f.out)
+	_, _ = green.Fprintln(f.out, "📊 OVERVIEW")
+	fmt.Fprintln(f.out, strings.Repeat("-", 80))
+
+	table := tablewriter.NewWriter(f.out)
+	table.Header("Metric", "Value")
+	table.Options(
+		tablewriter.WithAlignment(tw.MakeAlign(2, tw.AlignLeft)),
+	)
+	_ = table.Append([]string{"Members", fmt.Sprintf("%d", report.Members)})
+	_ = table.Append([]string{"Active Members", fmt.Sprintf("%d", report.ActiveMembers)})
+	_ = table.Append([]string{"Total Contributions", fmt.Sprintf("%d", report.TotalContributions)})
+	_ = table.Append([]string{"Commits", fmt.Sprintf("%d", report.Commits)})
+	_ = table.Append([]string{"Pull Requests Opened", fmt.Sprintf("%d", report.PullRequests)})
+	_ = table.Append([]string{"Pull Requests Merged", fmt.Sprintf("%d (%.1f/week)", report.PullRequestsMerged, report.PRsPerWeek)})
+	_ = table.Append([]string{"Reviews", fmt.Sprintf("%d (%.1f/week)", report.Reviews, report.ReviewsPerWeek)})
+	_ = table.Append([]string{"Issues", fmt.Sprintf("%d", report.Issues)})
+	_ = table.Render()
+
+	if len(report.TopContributors) > 0 {
+		fmt.Fprintln(f.out)
+		_, _ = green.Fprintln(f.out, "🏆 TOP CONTRIBUTORS")
+		fmt.Fprintln(f.out, strings.Repeat("-", 80))
+
+		table = tablewriter.NewWriter(f.out)
+		table.Header("Member", "Contributions")
+		table.Options(
+			tablewriter.WithAlignment(tw.MakeAlign(2, tw.AlignLeft)),
+		)
+		for _, user := range report.TopContributors {
+			_ = table.Append([]string{user.Login, fmt.Sprintf("%d", user.Count)})
+		}
+		_ = table.Render()
+	}
+
+	if len(report.TopReviewers) > 0 {
+		fmt.Fprintln(f.out)
+		_, _ = green.Fprintln(f.out, "👀 TOP REVIEWERS")
+		fmt.Fprintln(f.out, strings.Repeat("-", 80))
+
+		table = tablewriter.NewWriter(f.out)
+		table.Header("Member", "Reviews")
+		table.Options(
+			tablewriter.WithAlignment(tw.MakeAlign(2, tw.AlignLeft)),
+		)
+		for _, user := range report.TopReviewers {
+			_ = table.Append([]string{user.Login, fmt.Sprintf("%d", user.Count)})
+		}
+		_ = table.Render()
+	}
+
+	if len(report.Languages) > 0 {
+		fmt.Fprintln(f.out)
+		_, _ = green.Fprintln(f.out, "💻 LANGUAGE MIX (org repositories)")
+		fmt.Fprintln(f.out, strings.Repeat("-", 80))
+
+		table = tablewriter.NewWriter(f.out)
+		table.Header("Language", "Percentage")
+		table.Options(
+			tablewriter.WithAlignment(tw.MakeAlign(2, tw.AlignLeft)),
+		)
+		for i, lang := range report.Languages {
+			if i >= 10 {
+				break
+			}
+			_ = table.Append([]string{lang.Name, fmt.Sprintf("%.1f%%", lang.Percentage)})
+		}
+		_ = table.Render()
+	}
+
+	if len(report.InactiveMembers) > 0 {
+		fmt.Fprintln(f.out)
+		_, _ = yellow.Fprintf(f.out, "💤 MEMBERS WITH NO ACTIVITY (%d)\n", len(report.InactiveMembers))
+		fmt.Fprintln(f.out, strings.Repeat("-", 80))
+		for _, login := range report.InactiveMembers {
+			fmt.Fprintf(f.out, "  - @%s\n", login)
+		}
+	}
+
+	if len(report.FailedMembers) > 0 {
+		fmt.Fprintln(f.out)
+		_, _ = yellow.Fprintf(f.out, "⚠️  Could not fetch %d members: %s\n", len(report.FailedMembers), strings.Join(report.FailedMembers, ", "))
+	}
+
+	fmt.Fprintln(f.out)
+	_, _ = blue.Fprintln(f.out, strings.Repeat("-", 80))
+	_, _ = blue.Fprintf(f.out, "Generated at: %s\n", time.Now().Format("2006-01-02 15:04:05 MST"))
+	_, _ = blue.Fprintln(f.out, strings.Repeat("=", 80))
+	fmt.Fprintln(f.out)
+
+	return nil
+}
+
+func (f *Formatter) displayOrgCSV(report *github.OrgReport) error {
+	writer := csv.NewWriter(f.out)
+	_ = writer.Write([]string{"login", "contributions", "commits", "pull_requests", "pull_requests_merged", "reviews", "issues"})
+	for _, member := range report.MemberStats {
+		_ = writer.Write([]string{
+			member.Login,
+			strconv.Itoa(member.TotalContributions),
+			strconv.Itoa(member.Commits),
+			strconv.Itoa(member.PullRequests),
+			strconv.Itoa(member.PullRequestsMerged),
+			strconv.Itoa(member.Reviews),
+			strconv.Itoa(member.Issues),
+		})
+	}
+	writer.Flush()
+	return writer.Error()
+}
@@ -0,0 +1,78 @@
+package display
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fatih/color"
+)
+
+const progressBarWidth = 30
+
+// ProgressBar renders a single updating line with completed/total counts
+// and an ETA extrapolated from the items finished since it started, so
+// items restored from a checkpoint do not skew the estimate.
+type ProgressBar struct {
+	mu        sync.Mutex
+	label     string
+	started   bool
+	start     time.Time
+	startDone int
+	rendered  bool
+}
+
+func NewProgressBar(label string) *ProgressBar {
+	return &ProgressBar{label: label}
+}
+
+func (p *ProgressBar) Update(done, total int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !p.started {
+		p.started = true
+		p.start = time.Now()
+		p.startDone = done
+	}
+
+	filled := 0
+	if total > 0 {
+		filled = done * progressBarWidth / total
+	}
+	bar := strings.Repeat("█", filled) + strings.Repeat("░", progressBarWidth-filled)
+
+	eta := "--"
+	if finished := done - p.startDone; finished > 0 && done < total {
+		perItem := time.Since(p.start) / time.Duration(finished)
+		eta = formatETA(perItem * time.Duration(total-done))
+	} else if done >= total {
+		eta = "done"
+	}
+
+	cyan := color.New(color.FgCyan)
+	_, _ = cyan.Fprintf(os.Stderr, "\r⏳ %s [%s] %d/%d  ETA %-8s", p.label, bar, done, total, eta)
+	p.rendered = true
+}
+
+func (p *ProgressBar) Finish() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.rendered {
+		fmt.Fprintln(os.Stderr)
+		p.rendered = false
+	}
+}
+
+func formatETA(d time.Duration) string {
+	d = d.Round(time.Second)
+	if d >= time.Hour {
+		return fmt.Sprintf("%dh%02dm", int(d.Hours()), int(d.Minutes())%60)
+	}
+	if d >= time.Minute {
+		return fmt.Sprintf("%dm%02ds", int(d.Minutes()), int(d.Seconds())%60)
+	}
+	return fmt.Sprintf("%ds", int(d.Seconds()))
+}
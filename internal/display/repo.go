@@ -0,0 +1,185 @@
+package display
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github-stats/internal/github"
+
+	"github.com/fatih/color"
+	"github.com/olekukonko/tablewriter"
+	"github.com/olekukonko/tablewriter/tw"
+)
+
+func (f *Formatter) DisplayRepo(report *github.RepoReport) error {
+	switch f.format {
+	case "json":
+		encoder := json.NewEncoder(f.out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(report)
+	case "table":
+		return f.displayRepoTable(report)
+	default:
+		return fmt.Errorf("unsupported format: %s", f.format)
+	}
+}
+
+func (f *Formatter) displayRepoTable(report *github.RepoReport) error {
+	cyan := color.New(color.FgCyan, color.Bold)
+	green := color.New(color.FgGreen)
+	blue := color.New(color.FgBlue)
+
+	_, _ = cyan.Fprintln(f.out, "\n"+strings.Repeat("=", 80))
+	_, _ = cyan.Fprintf(f.out, "  📦 Repository Statistics for %s\n", report.FullName)
+	_, _ = cyan.Fprintln(f.out, strings.Repeat("=", 80))
+	if report.Description != "" {
+		fmt.Fprintf(f.out, "  %s\n", report.Description)
+	}
+	fmt.Fprintf(f.out, "  Window: %s - %s\n", report.Since.Format("2006-01-02"), report.Until.Format("2006-01-02"))
+
+	fmt.Fprintln(f.out)
+	_, _ = green.Fprintln(f.out, "📊 OVERVIEW")
+	fmt.Fprintln(f.out, strings.Repeat("-", 80))
+
+	table := tablewriter.NewWriter(f.out)
+	table.Header("Metric", "Value")
+	table.Options(
+		tablewriter.WithAlignment(tw.MakeAlign(2, tw.AlignLeft)),
+	)
+	_ = table.Append([]string{"Stars", fmt.Sprintf("%d ⭐", report.Stars)})
+	_ = table.Append([]string{"Forks", fmt.Sprintf("%d", report.Forks)})
+	_ = table.Append([]string{"Watchers", fmt.Sprintf("%d", report.Watchers)})
+	_ = table.Append([]string{"Open Issues & PRs", fmt.Sprintf("%d", report.OpenIssues)})
+	_ = table.Append([]string{"Commits in Window", fmt.Sprintf("%d", report.Commits)})
+	_ = table.Render()
+
+	if len(report.TopContributors) > 0 {
+		fmt.Fprintln(f.out)
+		_, _ = green.Fprintln(f.out, "🏆 CONTRIBUTOR LEADERBOARD (commits in window)")
+		fmt.Fprintln(f.out, strings.Repeat("-", 80))
+
+		table = tablewriter.NewWriter(f.out)
+		table.Header("Rank", "Contributor", "Commits")
+		table.Options(
+			tablewriter.WithAlignment(tw.MakeAlign(3, tw.AlignLeft)),
+		)
+		for i, user := range report.TopContributors {
+			_ = table.Append([]string{fmt.Sprintf("%d", i+1), user.Login, fmt.Sprintf("%d", user.Count)})
+		}
+		_ = table.Render()
+	}
+
+	if len(report.WeeklyCommits) > 0 {
+		fmt.Fprintln(f.out)
+		_, _ = green.Fprintln(f.out, "📈 COMMITS PER WEEK")
+		fmt.Fprintln(f.out, strings.Repeat("-", 80))
+
+		maxCount := 0
+		for _, week := range report.WeeklyCommits {
+			if week.Count > maxCount {
+				maxCount = week.Count
+			}
+		}
+		for _, week := range report.WeeklyCommits {
+			barLen := 0
+			if maxCount > 0 {
+				barLen = week.Count * 50 / maxCount
+			}
+			fmt.Fprintf(f.out, "  %s %s %d\n", week.Week.Format("2006-01-02"), strings.Repeat("█", barLen), week.Count)
+		}
+	}
+
+	fmt.Fprintln(f.out)
+	_, _ = green.Fprintln(f.out, "🔀 PULL REQUESTS")
+	fmt.Fprintln(f.out, strings.Repeat("-", 80))
+
+	table = tablewriter.NewWriter(f.out)
+	table.Header("Metric", "Value")
+	table.Options(
+		tablewriter.WithAlignment(tw.MakeAlign(2, tw.AlignLeft)),
+	)
+	_ = table.Append([]string{"Opened", fmt.Sprintf("%d", report.PRsOpened)})
+	_ = table.Append([]string{"Merged", fmt.Sprintf("%d", report.PRsMerged)})
+	_ = table.Append([]string{"Closed Unmerged", fmt.Sprintf("%d", report.PRsClosed)})
+	_ = table.Append([]string{"Merged per Week", fmt.Sprintf("%.1f", report.PRsMergedPerWeek)})
+	appendDurationRows(table, "Merge Time", report.MergeTime)
+	appendDurationRows(table, "Time to First Review", report.PRFirstResponse)
+	_ = table.Render()
+
+	fmt.Fprintln(f.out)
+	_, _ = green.Fprintln(f.out, "🐛 ISSUES")
+	fmt.Fprintln(f.out, strings.Repeat("-", 80))
+
+	table = tablewriter.NewWriter(f.out)
+	table.Header("Metric", "Value")
+	table.Options(
+		tablewriter.WithAlignment(tw.MakeAlign(2, tw.AlignLeft)),
+	)
+	_ = table.Append([]string{"Opened", fmt.Sprintf("%d (%.1f/week)", report.IssuesOpened, report.IssuesOpenedPerWeek)})
+	_ = table.Append([]string{"Closed", fmt.Sprintf("%d (%.1f/week)", report.IssuesClosed, report.IssuesClosedPerWeek)})
+	appendDurationRows(table, "Time to First Response", report.IssueFirstResponse)
+	appendDurationRows(table, "Close Time", report.IssueCloseTime)
+	_ = table.Render()
+
+	fmt.Fprintln(f.out)
+	_, _ = green.Fprintln(f.out, "🏷️  RELEASES")
+	fmt.Fprintln(f.out, strings.Repeat("-", 80))
+
+	table = tablewriter.NewWriter(f.out)
+	table.Header("Metric", "Value")
+	table.Options(
+		tablewriter.WithAlignment(tw.MakeAlign(2, tw.AlignLeft)),
+	)
+	_ = table.Append([]string{"Releases in Window", fmt.Sprintf("%d", report.Releases)})
+	_ = table.Append([]string{"Total Releases", fmt.Sprintf("%d", report.TotalReleases)})
+	if report.LatestRelease != "" {
+		_ = table.Append([]string{"Latest Release", fmt.Sprintf("%s (%s)", report.LatestRelease, formatAgo(report.LatestReleaseAt))})
+	}
+	appendDurationRows(table, "Time Between Releases", report.ReleaseInterval)
+	_ = table.Render()
+
+	if len(report.Languages) > 0 {
+		fmt.Fprintln(f.out)
+		_, _ = green.Fprintln(f.out, "💻 LANGUAGES")
+		fmt.Fprintln(f.out, strings.Repeat("-", 80))
+
+		table = tablewriter.NewWriter(f.out)
+		table.Header("Language", "Bytes", "Percentage")
+		table.Options(
+			tablewriter.WithAlignment(tw.MakeAlign(3, tw.AlignLeft)),
+		)
+		for i, lang := range report.Languages {
+			if i >= 10 {
+				break
+			}
+			_ = table.Append([]string{lang.Name, formatBytes(int64(lang.Count)), fmt.Sprintf("%.1f%%", lang.Percentage)})
+		}
+		_ = table.Render()
+	}
+
+	if len(report.TopReviewers) > 0 {
+		fmt.Fprintln(f.out)
+		_, _ = green.Fprintln(f.out, "👀 TOP REVIEWERS")
+		fmt.Fprintln(f.out, strings.Repeat("-", 80))
+
+		table = tablewriter.NewWriter(f.out)
+		table.Header("Reviewer", "PRs Reviewed")
+		table.Options(
+			tablewriter.WithAlignment(tw.MakeAlign(2, tw.AlignLeft)),
+		)
+		for _, user := range report.TopReviewers {
+			_ = table.Append([]string{user.Login, fmt.Sprintf("%d", user.Count)})
+		}
+		_ = table.Render()
+	}
+
+	fmt.Fprintln(f.out)
+	_, _ = blue.Fprintln(f.out, strings.Repeat("-", 80))
+	_, _ = blue.Fprintf(f.out, "Generated at: %s\n", time.Now().Format("2006-01-02 15:04:05 MST"))
+	_, _ = blue.Fprintln(f.out, strings.Repeat("=", 80))
+	fmt.Fprintln(f.out)
+
+	return nil
+}
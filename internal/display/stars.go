@@ -0,0 +1,179 @@
+package display
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+	"time"
+
+	"github-stats/internal/github"
+
+	"github.com/fatih/color"
+	"github.com/olekukonko/tablewriter"
+	"github.com/olekukonko/tablewriter/tw"
+)
+
+func (f *Formatter) DisplayStars(history *github.StarHistory) error {
+	switch f.format {
+	case "json":
+		encoder := json.NewEncoder(f.out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(history)
+	case "table":
+		return f.displayStarsTable(history)
+	case "csv":
+		return f.displayStarsCSV(history)
+	case "svg":
+		return f.displayStarsSVG(history)
+	default:
+		return fmt.Errorf("unsupported format: %s", f.format)
+	}
+}
+
+func (f *Formatter) displayStarsTable(history *github.StarHistory) error {
+	cyan := color.New(color.FgCyan, color.Bold)
+	green := color.New(color.FgGreen)
+	blue := color.New(color.FgBlue)
+
+	_, _ = cyan.Fprintln(f.out, "\n"+strings.Repeat("=", 80))
+	_, _ = cyan.Fprintf(f.out, "  ⭐ Star History for @%s\n", history.Username)
+	_, _ = cyan.Fprintln(f.out, strings.Repeat("=", 80))
+
+	fmt.Fprintln(f.out)
+	_, _ = green.Fprintln(f.out, "📊 SUMMARY")
+	fmt.Fprintln(f.out, strings.Repeat("-", 80))
+
+	table := tablewriter.NewWriter(f.out)
+	table.Header("Metric", "Value")
+	table.Options(
+		tablewriter.WithAlignment(tw.MakeAlign(2, tw.AlignLeft)),
+	)
+	_ = table.Append([]string{"Total Stars", fmt.Sprintf("%d", history.TotalStars)})
+	_ = table.Append([]string{"Gained (last 30 days)", fmt.Sprintf("%d", history.Last30Days)})
+	_ = table.Append([]string{"Gained (last 90 days)", fmt.Sprintf("%d", history.Last90Days)})
+	if history.FastestGrowing != "" {
+		_ = table.Append([]string{"Fastest Growing", fmt.Sprintf("%s (+%d in 90 days)", history.FastestGrowing, history.FastestGrowingGain)})
+	}
+	_ = table.Render()
+
+	if len(history.Repos) > 0 {
+		fmt.Fprintln(f.out)
+		_, _ = green.Fprintln(f.out, "🚀 REPOSITORIES (by recent growth)")
+		fmt.Fprintln(f.out, strings.Repeat("-", 80))
+
+		table = tablewriter.NewWriter(f.out)
+		table.Header("Repository", "Stars", "Last 30 Days", "Last 90 Days", "Last Star")
+		table.Options(
+			tablewriter.WithAlignment(tw.MakeAlign(5, tw.AlignLeft)),
+		)
+		for i, repo := range history.Repos {
+			if i >= 10 {
+				break
+			}
+			_ = table.Append([]string{
+				repo.Name,
+				fmt.Sprintf("%d", repo.Stars),
+				fmt.Sprintf("+%d", repo.Last30Days),
+				fmt.Sprintf("+%d", repo.Last90Days),
+				formatAgo(repo.LastStar),
+			})
+		}
+		_ = table.Render()
+	}
+
+	if len(history.Monthly) > 0 {
+		fmt.Fprintln(f.out)
+		_, _ = green.Fprintln(f.out, "📈 STARS GAINED (last 12 months)")
+		fmt.Fprintln(f.out, strings.Repeat("-", 80))
+
+		months := history.Monthly
+		if len(months) > 12 {
+			months = months[len(months)-12:]
+		}
+		maxGained := 0
+		for _, month := range months {
+			if month.Gained > maxGained {
+				maxGained = month.Gained
+			}
+		}
+		for _, month := range months {
+			barLen := 0
+			if maxGained > 0 {
+				barLen = month.Gained * 40 / maxGained
+			}
+			fmt.Fprintf(f.out, "  %s %s %d (total %d)\n", month.Month, strings.Repeat("█", barLen), month.Gained, month.Total)
+		}
+	}
+
+	fmt.Fprintln(f.out)
+	_, _ = blue.Fprintln(f.out, strings.Repeat("-", 80))
+	_, _ = blue.Fprintf(f.out, "Generated at: %s\n", time.Now().Format("2006-01-02 15:04:05 MST"))
+	_, _ = blue.Fprintln(f.out, strings.Repeat("=", 80))
+	fmt.Fprintln(f.out)
+
+	return nil
+}
+
+func (f *Formatter) displayStarsCSV(history *github.StarHistory) error {
+	writer := csv.NewWriter(f.out)
+	_ = writer.Write([]string{"month", "gained", "total"})
+	for _, point := range history.Monthly {
+		_ = writer.Write([]string{point.Month, strconv.Itoa(point.Gained), strconv.Itoa(point.Total)})
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func (f *Formatter) displayStarsSVG(history *github.StarHistory) error {
+	const (
+		width   = 640
+		height  = 320
+		padding = 48
+		top     = 72
+	)
+
+	chartW := width - 2*padding
+	chartH := height - top - padding
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`+"\n",
+		width, height, width, height)
+	fmt.Fprintf(&b, `  <rect width="100%%" height="100%%" rx="12" fill="#0d1117"/>`+"\n")
+	fmt.Fprintf(&b, `  <text x="%d" y="40" font-family="Segoe UI, Helvetica, Arial, sans-serif" font-size="20" font-weight="bold" fill="#58a6ff">Star History · @%s · %d ⭐</text>`+"\n",
+		padding, html.EscapeString(history.Username), history.TotalStars)
+	fmt.Fprintf(&b, `  <line x1="%d" y1="%d" x2="%d" y2="%d" stroke="#30363d"/>`+"\n",
+		padding, top+chartH, padding+chartW, top+chartH)
+
+	points := history.Monthly
+	if len(points) > 0 {
+		maxTotal := points[len(points)-1].Total
+		var coords []string
+		for i, point := range points {
+			x := padding
+			if len(points) > 1 {
+				x = padding + i*chartW/(len(points)-1)
+			}
+			y := top + chartH
+			if maxTotal > 0 {
+				y = top + chartH - point.Total*chartH/maxTotal
+			}
+			coords = append(coords, fmt.Sprintf("%d,%d", x, y))
+		}
+		fmt.Fprintf(&b, `  <polyline points="%s" fill="none" stroke="#e3b341" stroke-width="2"/>`+"\n", strings.Join(coords, " "))
+
+		fmt.Fprintf(&b, `  <text x="%d" y="%d" font-family="Segoe UI, Helvetica, Arial, sans-serif" font-size="11" fill="#8b949e">%s</text>`+"\n",
+			padding, top+chartH+18, points[0].Month)
+		fmt.Fprintf(&b, `  <text x="%d" y="%d" font-family="Segoe UI, Helvetica, Arial, sans-serif" font-size="11" fill="#8b949e" text-anchor="end">%s</text>`+"\n",
+			padding+chartW, top+chartH+18, points[len(points)-1].Month)
+		fmt.Fprintf(&b, `  <text x="%d" y="%d" font-family="Segoe UI, Helvetica, Arial, sans-serif" font-size="11" fill="#8b949e" text-anchor="end">%d</text>`+"\n",
+			padding-6, top+4, maxTotal)
+	}
+
+	fmt.Fprintf(&b, "</svg>\n")
+
+	_, err := fmt.Fprint(f.out, b.String())
+	return err
+}
@@ -0,0 +1,266 @@
+package display
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"strings"
+	"time"
+
+	"github-stats/internal/github"
+
+	"github.com/fatih/color"
+	"github.com/olekukonko/tablewriter"
+	"github.com/olekukonko/tablewriter/tw"
+)
+
+type wrappedRow struct {
+	label    string
+	value    string
+	current  int
+	previous int
+	compare  bool
+}
+
+func (f *Formatter) DisplayWrapped(report *github.WrappedReport) error {
+	switch f.format {
+	case "json":
+		encoder := json.NewEncoder(f.out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(report)
+	case "table":
+		return f.displayWrappedTable(report)
+	case "markdown":
+		return f.displayWrappedMarkdown(report)
+	case "svg":
+		return f.displayWrappedSVG(report)
+	default:
+		return fmt.Errorf("unsupported format: %s", f.format)
+	}
+}
+
+func wrappedRows(report *github.WrappedReport) []wrappedRow {
+	t := report.Totals
+	var p github.YearTotals
+	if report.Previous != nil {
+		p = *report.Previous
+	}
+	compare := report.Previous != nil
+
+	rows := []wrappedRow{
+		{"Total Contributions", fmt.Sprintf("%d", t.TotalContributions), t.TotalContributions, p.TotalContributions, compare},
+		{"Commits", fmt.Sprintf("%d", t.Commits), t.Commits, p.Commits, compare},
+		{"Pull Requests Opened", fmt.Sprintf("%d", t.PullRequests), t.PullRequests, p.PullRequests, compare},
+		{"Pull Requests Merged", fmt.Sprintf("%d", t.PRsMerged), t.PRsMerged, p.PRsMerged, compare},
+		{"Reviews Given", fmt.Sprintf("%d", t.Reviews), t.Reviews, p.Reviews, compare},
+		{"Issues Opened", fmt.Sprintf("%d", t.Issues), t.Issues, p.Issues, compare},
+		{"Active Days", fmt.Sprintf("%d", t.ActiveDays), t.ActiveDays, p.ActiveDays, compare},
+		{"Longest Streak", fmt.Sprintf("%d days", t.LongestStreak), t.LongestStreak, p.LongestStreak, compare},
+	}
+
+	if report.BusiestMonth != "" {
+		rows = append(rows, wrappedRow{label: "Busiest Month",
+			value: fmt.Sprintf("%s (%d)", report.BusiestMonth, report.BusiestMonthCount)})
+	}
+	if !report.BusiestDay.IsZero() {
+		rows = append(rows, wrappedRow{label: "Busiest Day",
+			value: fmt.Sprintf("%s (%d)", report.BusiestDay.Format("Mon, Jan 2"), report.BusiestDayCount)})
+	}
+	if !report.LongestStreakStart.IsZero() {
+		rows = append(rows, wrappedRow{label: "Longest Streak Period",
+			value: fmt.Sprintf("%s - %s", report.LongestStreakStart.Format("Jan 2"), report.LongestStreakEnd.Format("Jan 2"))})
+	}
+	if !report.FirstContribution.IsZero() {
+		rows = append(rows, wrappedRow{label: "First Contribution", value: report.FirstContribution.Format("Jan 2")})
+		rows = append(rows, wrappedRow{label: "Last Contribution", value: report.LastContribution.Format("Jan 2")})
+	}
+
+	return rows
+}
+
+func (f *Formatter) displayWrappedTable(report *github.WrappedReport) error {
+	cyan := color.New(color.FgCyan, color.Bold)
+	green := color.New(color.FgGreen)
+	blue := color.New(color.FgBlue)
+
+	_, _ = cyan.Fprintln(f.out, "\n"+strings.Repeat("=", 80))
+	_, _ = cyan.Fprintf(f.out, "  🎁 %d Wrapped for @%s\n", report.Totals.Year, report.Username)
+	_, _ = cyan.Fprintln(f.out, strings.Repeat("=", 80))
+
+	fmt.Fprintln(f.out)
+	_, _ = green.Fprintln(f.out, "📅 YEAR IN NUMBERS")
+	fmt.Fprintln(f.out, strings.Repeat("-", 80))
+
+	table := tablewriter.NewWriter(f.out)
+	table.Header("Metric", "Value", fmt.Sprintf("vs %d", report.Totals.Year-1))
+	table.Options(
+		tablewriter.WithAlignment(tw.MakeAlign(3, tw.AlignLeft)),
+	)
+
+	for _, row := range wrappedRows(report) {
+		delta := ""
+		if row.compare {
+			delta = formatDelta(row.current, row.previous)
+		}
+		_ = table.Append([]string{row.label, row.value, delta})
+	}
+
+	_ = table.Render()
+
+	fmt.Fprintln(f.out)
+	_, _ = green.Fprintln(f.out, "📈 CONTRIBUTIONS BY MONTH")
+	fmt.Fprintln(f.out, strings.Repeat("-", 80))
+
+	maxCount := report.BusiestMonthCount
+	for _, month := range report.MonthlyContributions {
+		barLen := 0
+		if maxCount > 0 {
+			barLen = month.Count * 50 / maxCount
+		}
+		fmt.Fprintf(f.out, "  %-3s %s %d\n", month.Month[:3], strings.Repeat("█", barLen), month.Count)
+	}
+
+	if len(report.TopRepos) > 0 {
+		fmt.Fprintln(f.out)
+		_, _ = green.Fprintln(f.out, "🌟 TOP REPOSITORIES (by commits)")
+		fmt.Fprintln(f.out, strings.Repeat("-", 80))
+
+		table = tablewriter.NewWriter(f.out)
+		table.Header("Repository", "Commits")
+		table.Options(
+			tablewriter.WithAlignment(tw.MakeAlign(2, tw.AlignLeft)),
+		)
+		for _, repo := range report.TopRepos {
+			_ = table.Append([]string{repo.RepoName, fmt.Sprintf("%d", repo.Count)})
+		}
+		_ = table.Render()
+	}
+
+	if len(report.TopLanguages) > 0 {
+		fmt.Fprintln(f.out)
+		_, _ = green.Fprintln(f.out, "💻 TOP LANGUAGES (by commits)")
+		fmt.Fprintln(f.out, strings.Repeat("-", 80))
+
+		table = tablewriter.NewWriter(f.out)
+		table.Header("Language", "Commits", "Percentage")
+		table.Options(
+			tablewriter.WithAlignment(tw.MakeAlign(3, tw.AlignLeft)),
+		)
+		for _, lang := range report.TopLanguages {
+			_ = table.Append([]string{lang.Name, fmt.Sprintf("%d", lang.Count), fmt.Sprintf("%.1f%%", lang.Percentage)})
+		}
+		_ = table.Render()
+	}
+
+	fmt.Fprintln(f.out)
+	_, _ = blue.Fprintln(f.out, strings.Repeat("-", 80))
+	_, _ = blue.Fprintf(f.out, "Generated at: %s\n", time.Now().Format("2006-01-02 15:04:05 MST"))
+	_, _ = blue.Fprintln(f.out, strings.Repeat("=", 80))
+	fmt.Fprintln(f.out)
+
+	return nil
+}
+
+func (f *Formatter) displayWrappedMarkdown(report *github.WrappedReport) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# %d Wrapped for @%s\n\n", report.Totals.Year, report.Username)
+	if report.Name != "" {
+		fmt.Fprintf(&b, "_%s_\n\n", report.Name)
+	}
+
+	fmt.Fprintf(&b, "## Year in Numbers\n\n")
+	fmt.Fprintf(&b, "| Metric | Value | vs %d |\n", report.Totals.Year-1)
+	fmt.Fprintf(&b, "|---|---|---|\n")
+	for _, row := range wrappedRows(report) {
+		delta := ""
+		if row.compare {
+			delta = formatDelta(row.current, row.previous)
+		}
+		fmt.Fprintf(&b, "| %s | %s | %s |\n", row.label, row.value, delta)
+	}
+
+	fmt.Fprintf(&b, "\n## Contributions by Month\n\n")
+	fmt.Fprintf(&b, "| Month | Contributions |\n")
+	fmt.Fprintf(&b, "|---|---|\n")
+	for _, month := range report.MonthlyContributions {
+		fmt.Fprintf(&b, "| %s | %d |\n", month.Month, month.Count)
+	}
+
+	if len(report.TopRepos) > 0 {
+		fmt.Fprintf(&b, "\n## Top Repositories\n\n")
+		for i, repo := range report.TopRepos {
+			fmt.Fprintf(&b, "%d. **%s** — %d commits\n", i+1, repo.RepoName, repo.Count)
+		}
+	}
+
+	if len(report.TopLanguages) > 0 {
+		fmt.Fprintf(&b, "\n## Top Languages\n\n")
+		for i, lang := range report.TopLanguages {
+			fmt.Fprintf(&b, "%d. **%s** — %.1f%%\n", i+1, lang.Name, lang.Percentage)
+		}
+	}
+
+	_, err := fmt.Fprint(f.out, b.String())
+	return err
+}
+
+func (f *Formatter) displayWrappedSVG(report *github.WrappedReport) error {
+	const (
+		width      = 640
+		padding    = 24
+		lineHeight = 22
+		chartH     = 120
+	)
+
+	rows := wrappedRows(report)
+	top := 90 + (len(rows)+1)/2*lineHeight
+	height := top + chartH + 40
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`+"\n",
+		width, height, width, height)
+	fmt.Fprintf(&b, `  <rect width="100%%" height="100%%" rx="12" fill="#0d1117"/>`+"\n")
+	fmt.Fprintf(&b, `  <text x="%d" y="48" font-family="Segoe UI, Helvetica, Arial, sans-serif" font-size="24" font-weight="bold" fill="#58a6ff">%d Wrapped · @%s</text>`+"\n",
+		padding, report.Totals.Year, html.EscapeString(report.Username))
+
+	for i, row := range rows {
+		col := i % 2
+		line := i / 2
+		x := padding + col*(width/2)
+		y := 90 + line*lineHeight
+		fmt.Fprintf(&b, `  <text x="%d" y="%d" font-family="Segoe UI, Helvetica, Arial, sans-serif" font-size="13" fill="#8b949e">%s: <tspan fill="#c9d1d9" font-weight="bold">%s</tspan></text>`+"\n",
+			x, y, html.EscapeString(row.label), html.EscapeString(row.value))
+	}
+
+	barWidth := (width - 2*padding) / 12
+	maxCount := report.BusiestMonthCount
+	for i, month := range report.MonthlyContributions {
+		barHeight := 0
+		if maxCount > 0 {
+			barHeight = month.Count * chartH / maxCount
+		}
+		x := padding + i*barWidth
+		fmt.Fprintf(&b, `  <rect x="%d" y="%d" width="%d" height="%d" rx="3" fill="#39d353"/>`+"\n",
+			x+4, top+chartH-barHeight, barWidth-8, barHeight)
+		fmt.Fprintf(&b, `  <text x="%d" y="%d" font-family="Segoe UI, Helvetica, Arial, sans-serif" font-size="11" fill="#8b949e" text-anchor="middle">%s</text>`+"\n",
+			x+barWidth/2, top+chartH+16, month.Month[:3])
+	}
+
+	fmt.Fprintf(&b, "</svg>\n")
+
+	_, err := fmt.Fprint(f.out, b.String())
+	return err
+}
+
+func formatDelta(current, previous int) string {
+	diff := current - previous
+	sign := "+"
+	if diff < 0 {
+		sign = ""
+	}
+	if previous == 0 {
+		return fmt.Sprintf("%s%d", sign, diff)
+	}
+	return fmt.Sprintf("%s%d (%s%.0f%%)", sign, diff, sign, float64(diff)/float64(previous)*100.0)
+}
@@ -0,0 +1,156 @@
+package github
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/v81/github"
+)
+
+const (
+	ActivitySourceAuto     = "auto"
+	ActivitySourceCalendar = "calendar"
+	ActivitySourceEvents   = "events"
+	ActivitySourceCommits  = "commits"
+	ActivitySourceMerged   = "merged"
+)
+
+func (c *Client) GetCommitActivity(username string, repos []*github.Repository, since time.Time, source string, fullScan bool) (*CommitActivity, error) {
+	switch source {
+	case ActivitySourceCalendar:
+		calendar, err := c.GetContributionCalendar(username, since)
+		if err != nil {
+			return nil, err
+		}
+		return calendarActivity(calendar), nil
+	case ActivitySourceEvents:
+		dates, err := c.getCommitActivityRecent(username)
+		return &CommitActivity{Dates: dates, Source: ActivitySourceEvents}, err
+	case ActivitySourceCommits:
+		dates, repoCommits, err := c.getCommitActivityFull(username, repos)
+		return &CommitActivity{Dates: dates, Source: ActivitySourceCommits, RepoCommits: repoCommits, ScanComplete: err == nil}, err
+	case ActivitySourceMerged:
+		return c.getCommitActivityMerged(username, repos, since)
+	}
+
+	// A full scan always walks every repository's commits, merged with the
+	// calendar and events so nothing either of them sees is lost.
+	if fullScan {
+		return c.getCommitActivityMerged(username, repos, since)
+	}
+
+	calendar, err := c.GetContributionCalendar(username, since)
+	if err == nil && len(calendar.Dates) > 0 {
+		return calendarActivity(calendar), nil
+	}
+
+	dates, err := c.getCommitActivityRecent(username)
+	return &CommitActivity{Dates: dates, Source: ActivitySourceEvents}, err
+}
+
+func calendarActivity(calendar *ContributionCalendar) *CommitActivity {
+	return &CommitActivity{
+		Dates:        calendar.Dates,
+		Source:       ActivitySourceCalendar,
+		FetchedYears: calendar.FetchedYears,
+		FailedYears:  calendar.FailedYears,
+	}
+}
+
+func (c *Client) getCommitActivityMerged(username string, repos []*github.Repository, since time.Time) (*CommitActivity, error) {
+	var calendar *ContributionCalendar
+	var events, commits []time.Time
+	var repoCommits map[string][]time.Time
+	var calendarErr, eventsErr, commitsErr error
+	var wg sync.WaitGroup
+	wg.Add(3)
+
+	go func() {
+		defer wg.Done()
+		calendar, calendarErr = c.GetContributionCalendar(username, since)
+	}()
+
+	go func() {
+		defer wg.Done()
+		events, eventsErr = c.getCommitActivityRecent(username)
+	}()
+
+	go func() {
+		defer wg.Done()
+		commits, repoCommits, commitsErr = c.getCommitActivityFull(username, repos)
+	}()
+
+	wg.Wait()
+
+	if calendarErr != nil && eventsErr != nil && commitsErr != nil {
+		return nil, fmt.Errorf("all activity sources failed: %w", calendarErr)
+	}
+
+	activity := &CommitActivity{Source: ActivitySourceMerged, RepoCommits: repoCommits, ScanComplete: commitsErr == nil}
+	sources := make(map[string][]time.Time)
+
+	// Commits and events carry real timestamps, so they are merged first and
+	// the calendar's midnight dates only fill in days nobody else saw.
+	if commitsErr != nil {
+		warnf("commit scan incomplete: %v", commitsErr)
+	}
+	sources[ActivitySourceCommits] = commits
+
+	if eventsErr != nil {
+		warnf("failed to get events: %v", eventsErr)
+	}
+	sources[ActivitySourceEvents] = events
+
+	if calendarErr != nil {
+		warnf("failed to get contribution calendar: %v", calendarErr)
+	} else {
+		sources[ActivitySourceCalendar] = calendar.Dates
+		activity.FetchedYears = calendar.FetchedYears
+		activity.FailedYears = calendar.FailedYears
+	}
+
+	activity.Dates, activity.Provenance = mergeActivitySources(sources,
+		[]string{ActivitySourceCommits, ActivitySourceEvents, ActivitySourceCalendar})
+
+	return activity, nil
+}
+
+func mergeActivitySources(sources map[string][]time.Time, order []string) ([]time.Time, *ActivityProvenance) {
+	provenance := &ActivityProvenance{
+		Days:       make(map[string][]string),
+		SourceDays: make(map[string]int),
+		UniqueDays: make(map[string]int),
+	}
+
+	var dates []time.Time
+	for _, source := range order {
+		seen := make(map[string]bool)
+		for _, date := range sources[source] {
+			dateStr := date.UTC().Format("2006-01-02")
+			if seen[dateStr] {
+				continue
+			}
+			seen[dateStr] = true
+
+			if _, exists := provenance.Days[dateStr]; !exists {
+				dates = append(dates, date)
+			}
+			provenance.Days[dateStr] = append(provenance.Days[dateStr], source)
+			provenance.SourceDays[source]++
+		}
+	}
+
+	for _, daySources := range provenance.Days {
+		if len(daySources) == 1 {
+			provenance.UniqueDays[daySources[0]]++
+		}
+	}
+
+	sort.Slice(dates, func(i, j int) bool {
+		return dates[i].Before(dates[j])
+	})
+
+	return dates, provenance
+}
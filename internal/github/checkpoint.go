@@ -0,0 +1,160 @@
+package github
+
+import (
+	"time"
+
+	"github.com/google/go-github/v81/github"
+)
+
+// ScanCheckpoint records how far a full commit scan got, so an interrupted
+// scan can be resumed without refetching finished repositories.
+type ScanCheckpoint struct {
+	Username  string
+	StartedAt time.Time
+	Completed map[string]CompletedRepoScan
+	Pending   map[string]RepoScanProgress
+}
+
+// checkpointInterval bounds how often progress is written, since each write
+// re-encodes every finished repository's commit dates.
+const checkpointInterval = 5 * time.Second
+
+// CompletedRepoScan holds a finished repository's commits keyed by SHA,
+// valid while the repository has not been pushed to since PushedAt.
+type CompletedRepoScan struct {
+	PushedAt time.Time
+	Commits  map[string]time.Time
+}
+
+type RepoScanProgress struct {
+	NextPage int
+	Commits  map[string]time.Time
+}
+
+func NewScanCheckpoint(username string) *ScanCheckpoint {
+	return &ScanCheckpoint{
+		Username:  username,
+		StartedAt: time.Now().UTC(),
+		Completed: make(map[string]CompletedRepoScan),
+		Pending:   make(map[string]RepoScanProgress),
+	}
+}
+
+// SetScanCheckpoint makes full commit scans resume from checkpoint and
+// record their progress in it, calling save at most every
+// checkpointInterval and when the scan ends. Passing nil disables
+// checkpointing.
+func (c *Client) SetScanCheckpoint(checkpoint *ScanCheckpoint, save func(*ScanCheckpoint)) {
+	c.checkpointMu.Lock()
+	defer c.checkpointMu.Unlock()
+	if checkpoint != nil {
+		if checkpoint.Completed == nil {
+			checkpoint.Completed = make(map[string]CompletedRepoScan)
+		}
+		if checkpoint.Pending == nil {
+			checkpoint.Pending = make(map[string]RepoScanProgress)
+		}
+	}
+	c.checkpoint = checkpoint
+	c.saveCheckpoint = save
+	c.checkpointSavedAt = time.Now()
+}
+
+// SetScanProgress registers a callback receiving the number of finished
+// and total repositories as a full commit scan advances.
+func (c *Client) SetScanProgress(progress func(done, total int)) {
+	c.checkpointMu.Lock()
+	defer c.checkpointMu.Unlock()
+	c.scanProgress = progress
+}
+
+func (c *Client) reportScanProgress(done, total int) {
+	c.checkpointMu.Lock()
+	progress := c.scanProgress
+	c.checkpointMu.Unlock()
+	if progress != nil {
+		progress(done, total)
+	}
+}
+
+// completedRepoScan returns a repository's commits from the checkpoint and
+// when the scan began, unless it has been pushed to since it was scanned.
+func (c *Client) completedRepoScan(r *github.Repository) (map[string]time.Time, time.Time, bool) {
+	c.checkpointMu.Lock()
+	defer c.checkpointMu.Unlock()
+	if c.checkpoint == nil {
+		return nil, time.Time{}, false
+	}
+	completed, ok := c.checkpoint.Completed[r.GetFullName()]
+	if !ok || !completed.PushedAt.Equal(r.GetPushedAt().Time) {
+		return nil, time.Time{}, false
+	}
+	return completed.Commits, c.checkpoint.StartedAt, true
+}
+
+func (c *Client) resumeRepoScan(fullName string) (map[string]time.Time, int) {
+	c.checkpointMu.Lock()
+	defer c.checkpointMu.Unlock()
+	commits := make(map[string]time.Time)
+	if c.checkpoint == nil {
+		return commits, 0
+	}
+	pending, ok := c.checkpoint.Pending[fullName]
+	if !ok {
+		return commits, 0
+	}
+	for sha, date := range pending.Commits {
+		commits[sha] = date
+	}
+	return commits, pending.NextPage
+}
+
+// checkpointRepoScan records a repository's pagination progress, but only
+// when a save is due: copying the commits on every page would cost as much
+// as the scan itself on large repositories.
+func (c *Client) checkpointRepoScan(fullName string, commits map[string]time.Time, nextPage int) {
+	c.checkpointMu.Lock()
+	defer c.checkpointMu.Unlock()
+	if c.checkpoint == nil || time.Since(c.checkpointSavedAt) < checkpointInterval {
+		return
+	}
+	snapshot := make(map[string]time.Time, len(commits))
+	for sha, date := range commits {
+		snapshot[sha] = date
+	}
+	c.checkpoint.Pending[fullName] = RepoScanProgress{NextPage: nextPage, Commits: snapshot}
+	c.writeCheckpoint()
+}
+
+// completeRepoScan must only be called once a repository's pagination has
+// finished without error, so a resumed scan never skips missing commits.
+func (c *Client) completeRepoScan(r *github.Repository, commits map[string]time.Time) {
+	c.checkpointMu.Lock()
+	defer c.checkpointMu.Unlock()
+	if c.checkpoint == nil {
+		return
+	}
+	delete(c.checkpoint.Pending, r.GetFullName())
+	c.checkpoint.Completed[r.GetFullName()] = CompletedRepoScan{PushedAt: r.GetPushedAt().Time, Commits: commits}
+	if time.Since(c.checkpointSavedAt) >= checkpointInterval {
+		c.writeCheckpoint()
+	}
+}
+
+// flushCheckpoint writes any progress held back by the interval when a scan
+// ends, successfully or not.
+func (c *Client) flushCheckpoint() {
+	c.checkpointMu.Lock()
+	defer c.checkpointMu.Unlock()
+	if c.checkpoint == nil {
+		return
+	}
+	c.writeCheckpoint()
+}
+
+func (c *Client) writeCheckpoint() {
+	c.checkpointSavedAt = time.Now()
+	if c.saveCheckpoint != nil {
+		c.saveCheckpoint(c.checkpoint)
+	}
+}
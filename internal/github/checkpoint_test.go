@@ -0,0 +1,161 @@
+package github
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v81/github"
+)
+
+func namedRepo(name string) *github.Repository {
+	return &github.Repository{
+		Name:     github.Ptr(name),
+		FullName: github.Ptr("me/" + name),
+		Owner:    &github.User{Login: github.Ptr("me")},
+	}
+}
+
+func TestCommitScanResumesFromCheckpoint(t *testing.T) {
+	var mu sync.Mutex
+	var requests []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, r.URL.Path+"?page="+r.URL.Query().Get("page"))
+		mu.Unlock()
+		_, _ = fmt.Fprint(w, commitPage("b"))
+	})
+
+	checkpoint := NewScanCheckpoint("me")
+	checkpoint.Completed["me/done"] = CompletedRepoScan{
+		Commits: map[string]time.Time{"c": time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	checkpoint.Pending["me/repo"] = RepoScanProgress{
+		NextPage: 2,
+		Commits:  map[string]time.Time{"a": time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	saves := 0
+	client.SetScanCheckpoint(checkpoint, func(*ScanCheckpoint) { saves++ })
+
+	_, repoCommits, err := client.getCommitActivityFull("me", []*github.Repository{namedRepo("done"), namedRepo("repo")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(requests) != 1 || requests[0] != "/repos/me/repo/commits?page=2" {
+		t.Errorf("requests = %q, want only page 2 of me/repo", requests)
+	}
+	if got := len(repoCommits["me/done"]); got != 1 {
+		t.Errorf("me/done has %d commits, want 1 from the checkpoint", got)
+	}
+	if got := len(repoCommits["me/repo"]); got != 2 {
+		t.Errorf("me/repo has %d commits, want 2 (resumed plus fetched)", got)
+	}
+	if _, ok := checkpoint.Pending["me/repo"]; ok {
+		t.Error("me/repo still pending after finishing")
+	}
+	if _, ok := checkpoint.Completed["me/repo"]; !ok {
+		t.Error("me/repo not marked completed")
+	}
+	if saves == 0 {
+		t.Error("checkpoint not saved when the scan ended")
+	}
+}
+
+func TestCommitScanKeepsFailedRepoPending(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/repos/me/broken/commits" && r.URL.Query().Get("page") == "2" {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		if r.URL.Path == "/repos/me/broken/commits" {
+			w.Header().Set("Link", fmt.Sprintf(`<%s?page=2>; rel="next"`, r.URL.Path))
+		}
+		_, _ = fmt.Fprint(w, commitPage("a"))
+	})
+
+	checkpoint := NewScanCheckpoint("me")
+	saves := 0
+	client.SetScanCheckpoint(checkpoint, func(*ScanCheckpoint) { saves++ })
+
+	_, _, err := client.getCommitActivityFull("me", []*github.Repository{namedRepo("ok"), namedRepo("broken")})
+	if err == nil {
+		t.Fatal("getCommitActivityFull() error = nil, want error for failed page")
+	}
+
+	if _, ok := checkpoint.Completed["me/broken"]; ok {
+		t.Error("me/broken marked completed after a failed page")
+	}
+	if _, ok := checkpoint.Completed["me/ok"]; !ok {
+		t.Error("me/ok not marked completed")
+	}
+	if saves == 0 {
+		t.Error("checkpoint not saved after the scan failed")
+	}
+}
+
+func TestResumedScanRecordsCompletedReposInState(t *testing.T) {
+	requests := 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, _ = fmt.Fprint(w, commitPage("new"))
+	})
+
+	pushedAt := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	checkpoint := NewScanCheckpoint("me")
+	checkpoint.Completed["me/repo"] = CompletedRepoScan{
+		PushedAt: pushedAt,
+		Commits:  map[string]time.Time{"a": time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	client.SetScanCheckpoint(checkpoint, nil)
+	state := NewIncrementalState("me")
+	client.SetState(state)
+
+	if _, _, err := client.getCommitActivityFull("me", []*github.Repository{testRepo(pushedAt)}); err != nil {
+		t.Fatal(err)
+	}
+	if requests != 0 {
+		t.Fatalf("made %d requests, want the repository served from the checkpoint", requests)
+	}
+
+	stored := state.Repos["me/repo"]
+	if stored == nil || len(stored.Commits) != 1 || !stored.CommitsPushedAt.Equal(pushedAt) || !stored.CommitsFetchedAt.Equal(checkpoint.StartedAt) {
+		t.Fatalf("state after resume = %+v, want the checkpointed commits as of the scan start", stored)
+	}
+
+	// The next incremental run serves the repository from state.
+	client.SetScanCheckpoint(nil, nil)
+	dates, err := client.repoCommitDates("me", testRepo(pushedAt))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if requests != 0 || len(dates) != 1 {
+		t.Errorf("next run made %d requests and found %d dates, want 0 and 1", requests, len(dates))
+	}
+}
+
+func TestResumedScanRescansReposPushedSince(t *testing.T) {
+	requests := 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, _ = fmt.Fprint(w, commitPage("a", "b"))
+	})
+
+	scannedAt := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	checkpoint := NewScanCheckpoint("me")
+	checkpoint.Completed["me/repo"] = CompletedRepoScan{
+		PushedAt: scannedAt,
+		Commits:  map[string]time.Time{"a": time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	client.SetScanCheckpoint(checkpoint, nil)
+
+	_, repoCommits, err := client.getCommitActivityFull("me", []*github.Repository{testRepo(scannedAt.Add(time.Hour))})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if requests != 1 || len(repoCommits["me/repo"]) != 2 {
+		t.Errorf("made %d requests and found %d commits, want a rescan finding 2", requests, len(repoCommits["me/repo"]))
+	}
+}
@@ -1,13 +1,11 @@
 package github
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
-	"strings"
+	"os"
+	"sort"
 	"sync"
 	"time"
 
@@ -21,34 +19,48 @@ type Client struct {
 	token      string
 	ctx        context.Context
 	maxWorkers int
-}
 
-const graphQLEndpoint = "https://api.github.com/graphql"
+	authOnce  sync.Once
+	authLogin string
+	authErr   error
+
+	cacheMu       sync.Mutex
+	orgRepoCache  map[string][]*github.Repository
+	languageCache map[string]map[string]int64
+	userOrgCache  map[string][]string
+
+	stateMu sync.Mutex
+	state   *IncrementalState
 
-type graphQLRequest struct {
-	Query     string                 `json:"query"`
-	Variables map[string]interface{} `json:"variables"`
+	checkpointMu      sync.Mutex
+	checkpoint        *ScanCheckpoint
+	saveCheckpoint    func(*ScanCheckpoint)
+	checkpointSavedAt time.Time
+	scanProgress      func(done, total int)
 }
 
 type contributionCalendarResponse struct {
-	Data struct {
-		User struct {
-			ContributionsCollection struct {
-				ContributionCalendar struct {
-					TotalContributions int `json:"totalContributions"`
-					Weeks              []struct {
-						ContributionDays []struct {
-							Date              string `json:"date"`
-							ContributionCount int    `json:"contributionCount"`
-						} `json:"contributionDays"`
-					} `json:"weeks"`
-				} `json:"contributionCalendar"`
-			} `json:"contributionsCollection"`
-		} `json:"user"`
-	} `json:"data"`
-	Errors []struct {
-		Message string `json:"message"`
-	} `json:"errors"`
+	User struct {
+		ContributionsCollection struct {
+			ContributionCalendar contributionCalendar `json:"contributionCalendar"`
+		} `json:"contributionsCollection"`
+	} `json:"user"`
+}
+
+type contributionCalendar struct {
+	TotalContributions int `json:"totalContributions"`
+	Weeks              []struct {
+		ContributionDays []struct {
+			Date              string `json:"date"`
+			ContributionCount int    `json:"contributionCount"`
+		} `json:"contributionDays"`
+	} `json:"weeks"`
+}
+
+// warnf reports a non-fatal problem on stderr so it never ends up in output
+// that has been redirected to a file.
+func warnf(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "Warning: "+format+"\n", args...)
 }
 
 func NewClient(ctx context.Context, token string, maxWorkers int) *Client {
@@ -63,18 +75,27 @@ func NewClient(ctx context.Context, token string, maxWorkers int) *Client {
 		token:      token,
 		ctx:        ctx,
 		maxWorkers: maxWorkers,
+
+		orgRepoCache:  make(map[string][]*github.Repository),
+		languageCache: make(map[string]map[string]int64),
+		userOrgCache:  make(map[string][]string),
 	}
 }
 
 func (c *Client) GetAuthenticatedUser() (string, error) {
-	user, _, err := c.client.Users.Get(c.ctx, "")
-	if err != nil {
-		return "", fmt.Errorf("failed to get authenticated user: %w", err)
-	}
-	if user.Login == nil {
-		return "", fmt.Errorf("authenticated user has no login")
-	}
-	return *user.Login, nil
+	c.authOnce.Do(func() {
+		user, _, err := c.client.Users.Get(c.ctx, "")
+		if err != nil {
+			c.authErr = fmt.Errorf("failed to get authenticated user: %w", err)
+			return
+		}
+		if user.Login == nil {
+			c.authErr = fmt.Errorf("authenticated user has no login")
+			return
+		}
+		c.authLogin = *user.Login
+	})
+	return c.authLogin, c.authErr
 }
 
 func (c *Client) GetUser(username string) (*github.User, error) {
@@ -88,21 +109,19 @@ func (c *Client) GetUser(username string) (*github.User, error) {
 	return user, nil
 }
 
-func (c *Client) GetRepositories(username string) ([]*github.Repository, error) {
-	var allRepos []*github.Repository
-	opts := &github.RepositoryListByUserOptions{
-		Type:        "owner",
-		Sort:        "updated",
-		ListOptions: github.ListOptions{PerPage: 100},
-	}
+func (c *Client) GetFollowers(username string) ([]string, error) {
+	followers := make([]string, 0)
+	opts := &github.ListOptions{PerPage: 100}
 
 	for {
-		repos, resp, err := c.client.Repositories.ListByUser(c.ctx, username, opts)
+		users, resp, err := c.client.Users.ListFollowers(c.ctx, username, opts)
 		if err != nil {
-			return nil, fmt.Errorf("failed to list repositories: %w", err)
+			return nil, fmt.Errorf("failed to list followers: %w", err)
 		}
 
-		allRepos = append(allRepos, repos...)
+		for _, user := range users {
+			followers = append(followers, user.GetLogin())
+		}
 
 		if resp.NextPage == 0 {
 			break
@@ -110,65 +129,18 @@ func (c *Client) GetRepositories(username string) ([]*github.Repository, error)
 		opts.Page = resp.NextPage
 	}
 
-	return allRepos, nil
+	return followers, nil
 }
 
-func (c *Client) GetLanguages(repos []*github.Repository) (map[string]int64, error) {
-	languages := make(map[string]int64)
-	var mu sync.Mutex
-	var wg sync.WaitGroup
-
-	sem := make(chan struct{}, c.maxWorkers)
-	errChan := make(chan error, len(repos))
-
-	for _, repo := range repos {
-		if repo.Fork != nil && *repo.Fork {
-			continue
-		}
-
-		wg.Add(1)
-		go func(r *github.Repository) {
-			defer wg.Done()
-			sem <- struct{}{}
-			defer func() { <-sem }()
-			langs, _, err := c.client.Repositories.ListLanguages(c.ctx,
-				*r.Owner.Login, *r.Name)
-			if err != nil {
-				errChan <- fmt.Errorf("failed to get languages for %s: %w", *r.Name, err)
-				return
-			}
-
-			mu.Lock()
-			for lang, bytes := range langs {
-				languages[lang] += int64(bytes)
-			}
-			mu.Unlock()
-		}(repo)
-	}
-
-	wg.Wait()
-	close(errChan)
-
-	var firstErr error
-	for err := range errChan {
-		if firstErr == nil {
-			firstErr = err
-		}
-	}
-
-	return languages, firstErr
-}
-
-func (c *Client) GetCommitActivity(username string, fullScan bool) ([]time.Time, error) {
-	dates, err := c.GetContributionCalendar(username)
-	if err == nil && len(dates) > 0 {
-		return dates, nil
+func (c *Client) RateLimitRemaining() (int, time.Time, error) {
+	limits, err := c.CheckRateLimit()
+	if err != nil {
+		return 0, time.Time{}, err
 	}
-
-	if fullScan {
-		return c.getCommitActivityFull(username)
+	if limits.Core == nil {
+		return 0, time.Time{}, fmt.Errorf("rate limit information unavailable")
 	}
-	return c.getCommitActivityRecent(username)
+	return limits.Core.Remaining, limits.Core.Reset.Time, nil
 }
 
 func (c *Client) getCommitActivityRecent(username string) ([]time.Time, error) {
@@ -203,41 +175,59 @@ func (c *Client) getCommitActivityRecent(username string) ([]time.Time, error) {
 	return commitDates, nil
 }
 
-func (c *Client) getCommitActivityFull(username string) ([]time.Time, error) {
-	repos, err := c.GetRepositories(username)
-	if err != nil {
-		return nil, err
-	}
-
+func (c *Client) getCommitActivityFull(username string, repos []*github.Repository) ([]time.Time, map[string][]time.Time, error) {
 	var mu sync.Mutex
 	var wg sync.WaitGroup
 	dateSet := make(map[string]bool)
 	var commitDates []time.Time
+	repoCommits := make(map[string][]time.Time)
 
-	sem := make(chan struct{}, c.maxWorkers)
-	errChan := make(chan error, len(repos))
+	record := func(fullName string, dates []time.Time) {
+		repoCommits[fullName] = dates
+		for _, date := range dates {
+			dateStr := date.Format("2006-01-02")
+			if !dateSet[dateStr] {
+				dateSet[dateStr] = true
+				commitDates = append(commitDates, date)
+			}
+		}
+	}
 
+	var pending []*github.Repository
 	for _, repo := range repos {
+		// Repositories finished before an interruption go into state as if
+		// fetched in this run, so the next incremental run builds on them.
+		if commits, scannedAt, ok := c.completedRepoScan(repo); ok {
+			c.storeRepoCommits(repo.GetFullName(), commits, repo.GetPushedAt().Time, scannedAt)
+			record(repo.GetFullName(), sortedCommitDates(commits))
+			continue
+		}
+		pending = append(pending, repo)
+	}
+	done := len(repos) - len(pending)
+	c.reportScanProgress(done, len(repos))
+
+	sem := make(chan struct{}, c.maxWorkers)
+	errChan := make(chan error, len(pending))
+
+	for _, repo := range pending {
 		wg.Add(1)
 		go func(r *github.Repository) {
 			defer wg.Done()
 			sem <- struct{}{}
 			defer func() { <-sem }()
 
-			dates, err := c.getRepoCommits(username, *r.Owner.Login, *r.Name)
+			commits, err := c.repoCommits(username, r)
 			if err != nil {
 				errChan <- err
 				return
 			}
+			c.completeRepoScan(r, commits)
 
 			mu.Lock()
-			for _, date := range dates {
-				dateStr := date.Format("2006-01-02")
-				if !dateSet[dateStr] {
-					dateSet[dateStr] = true
-					commitDates = append(commitDates, date)
-				}
-			}
+			record(r.GetFullName(), sortedCommitDates(commits))
+			done++
+			c.reportScanProgress(done, len(repos))
 			mu.Unlock()
 		}(repo)
 	}
@@ -251,26 +241,44 @@ func (c *Client) getCommitActivityFull(username string) ([]time.Time, error) {
 			firstErr = err
 		}
 	}
+	c.flushCheckpoint()
 
-	return commitDates, firstErr
+	return commitDates, repoCommits, firstErr
 }
 
 func (c *Client) getRepoCommits(author, owner, repo string) ([]time.Time, error) {
-	var dates []time.Time
+	commits, err := c.listRepoCommits(author, owner, repo, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+	return sortedCommitDates(commits), nil
+}
+
+// listRepoCommits returns the author's commits in a repository keyed by SHA,
+// resuming from and checkpointing to the scan checkpoint when one is set.
+func (c *Client) listRepoCommits(author, owner, repo string, since time.Time) (map[string]time.Time, error) {
+	fullName := owner + "/" + repo
+	commits, page := c.resumeRepoScan(fullName)
 	opts := &github.CommitsListOptions{
 		Author:      author,
-		ListOptions: github.ListOptions{PerPage: 100},
+		Since:       since,
+		ListOptions: github.ListOptions{PerPage: 100, Page: page},
 	}
 
 	for {
-		commits, resp, err := c.client.Repositories.ListCommits(c.ctx, owner, repo, opts)
+		page, resp, err := c.client.Repositories.ListCommits(c.ctx, owner, repo, opts)
 		if err != nil {
-			return dates, nil
+			// Anything but a repository without commits would leave the
+			// list partial.
+			if hasNoCommits(resp) {
+				return commits, nil
+			}
+			return nil, fmt.Errorf("failed to list commits for %s: %w", fullName, err)
 		}
 
-		for _, commit := range commits {
+		for _, commit := range page {
 			if commit.Commit != nil && commit.Commit.Author != nil && commit.Commit.Author.Date != nil {
-				dates = append(dates, commit.Commit.Author.Date.UTC())
+				commits[commit.GetSHA()] = commit.Commit.Author.Date.UTC()
 			}
 		}
 
@@ -278,395 +286,276 @@ func (c *Client) getRepoCommits(author, owner, repo string) ([]time.Time, error)
 			break
 		}
 		opts.Page = resp.NextPage
+		c.checkpointRepoScan(fullName, commits, opts.Page)
 	}
 
-	return dates, nil
+	return commits, nil
 }
 
-func (c *Client) GetContributionCalendar(username string) ([]time.Time, error) {
-	now := time.Now().UTC()
-	var allDates []time.Time
-	dateSet := make(map[string]bool)
-
-	for yearsBack := 0; yearsBack < 5; yearsBack++ {
-		to := now.AddDate(-yearsBack, 0, 0)
-		from := to.AddDate(-1, 0, 0)
-
-		if from.After(now) {
-			continue
-		}
-		if to.After(now) {
-			to = now
-		}
+func sortedCommitDates(commits map[string]time.Time) []time.Time {
+	dates := make([]time.Time, 0, len(commits))
+	for _, date := range commits {
+		dates = append(dates, date)
+	}
+	sort.Slice(dates, func(i, j int) bool { return dates[i].After(dates[j]) })
+	return dates
+}
 
-		dates, err := c.getContributionsForPeriod(username, from, to)
-		if err != nil {
-			if yearsBack > 0 {
-				break
-			}
-			return nil, err
-		}
+func (c *Client) GetRepoCommitCounts(author string, repos []*github.Repository) (map[string]int, error) {
+	counts := make(map[string]int)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
 
-		for _, date := range dates {
-			dateStr := date.Format("2006-01-02")
-			if !dateSet[dateStr] {
-				dateSet[dateStr] = true
-				allDates = append(allDates, date)
-			}
-		}
-	}
+	sem := make(chan struct{}, c.maxWorkers)
+	errChan := make(chan error, len(repos))
 
-	return allDates, nil
-}
+	for _, repo := range repos {
+		wg.Add(1)
+		go func(r *github.Repository) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
 
-func (c *Client) getContributionsForPeriod(username string, from, to time.Time) ([]time.Time, error) {
-	query := `
-		query($username: String!, $from: DateTime!, $to: DateTime!) {
-			user(login: $username) {
-				contributionsCollection(from: $from, to: $to) {
-					contributionCalendar {
-						totalContributions
-						weeks {
-							contributionDays {
-								date
-								contributionCount
-							}
-						}
-					}
-				}
+			count, err := c.countRepoCommits(author, *r.Owner.Login, *r.Name)
+			if err != nil {
+				errChan <- err
+				return
 			}
-		}
-	`
 
-	variables := map[string]interface{}{
-		"username": username,
-		"from":     from.Format(time.RFC3339),
-		"to":       to.Format(time.RFC3339),
+			mu.Lock()
+			counts[r.GetFullName()] = count
+			mu.Unlock()
+		}(repo)
 	}
 
-	reqBody := graphQLRequest{
-		Query:     query,
-		Variables: variables,
-	}
+	wg.Wait()
+	close(errChan)
 
-	jsonBody, err := json.Marshal(reqBody)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	var firstErr error
+	for err := range errChan {
+		if firstErr == nil {
+			firstErr = err
+		}
 	}
 
-	req, err := http.NewRequestWithContext(c.ctx, "POST", graphQLEndpoint, bytes.NewBuffer(jsonBody))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
+	return counts, firstErr
+}
 
-	req.Header.Set("Content-Type", "application/json")
+// hasNoCommits reports whether a failed commit listing means the repository
+// simply has no commits to count: it is empty (409), or was deleted or made
+// private since it was listed (404).
+func hasNoCommits(resp *github.Response) bool {
+	return resp != nil && (resp.StatusCode == 409 || resp.StatusCode == 404)
+}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to execute request: %w", err)
+func (c *Client) countRepoCommits(author, owner, repo string) (int, error) {
+	opts := &github.CommitsListOptions{
+		Author:      author,
+		ListOptions: github.ListOptions{PerPage: 1},
 	}
-	defer func() { _ = resp.Body.Close() }()
 
-	body, err := io.ReadAll(resp.Body)
+	commits, resp, err := c.client.Repositories.ListCommits(c.ctx, owner, repo, opts)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
+		if hasNoCommits(resp) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to count commits for %s/%s: %w", owner, repo, err)
 	}
 
-	var result contributionCalendarResponse
-	if err := json.Unmarshal(body, &result); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
+	// With one commit per page, the last page number is the commit count.
+	if resp.LastPage > 0 {
+		return resp.LastPage, nil
 	}
+	return len(commits), nil
+}
 
-	if len(result.Errors) > 0 {
-		return nil, fmt.Errorf("GraphQL error: %s", result.Errors[0].Message)
+func (c *Client) GetContributionCalendar(username string, since time.Time) (*ContributionCalendar, error) {
+	now := time.Now().UTC()
+	if since.IsZero() || since.After(now) {
+		since = now.AddDate(-1, 0, 0)
 	}
 
-	var dates []time.Time
-	for _, week := range result.Data.User.ContributionsCollection.ContributionCalendar.Weeks {
-		for _, day := range week.ContributionDays {
-			if day.ContributionCount > 0 {
-				date, err := time.Parse("2006-01-02", day.Date)
-				if err != nil {
-					continue
-				}
-				dates = append(dates, date)
+	windows := yearWindows(since.UTC(), now)
+	if years, err := c.getContributionYears(username); err == nil && len(years) > 0 {
+		active := make(map[int]bool)
+		for _, year := range years {
+			active[year] = true
+		}
+		var filtered []timeWindow
+		for _, window := range windows {
+			if active[window.Year] {
+				filtered = append(filtered, window)
 			}
 		}
+		windows = filtered
 	}
 
-	return dates, nil
-}
-
-func (c *Client) CheckRateLimit() (*github.RateLimits, error) {
-	limits, _, err := c.client.RateLimit.Get(c.ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to check rate limit: %w", err)
-	}
-	return limits, nil
-}
-
-func (c *Client) GetUserPullRequests(username string) (*PullRequestStats, error) {
-	stats := &PullRequestStats{
-		TopRepos: make([]RepoCount, 0),
-	}
-
-	repoCount := make(map[string]int)
-	var mergeTimes []time.Duration
+	calendar := &ContributionCalendar{}
+	dateSet := make(map[string]bool)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	var firstErr error
 
-	query := fmt.Sprintf("author:%s is:pr", username)
-	opts := &github.SearchOptions{
-		Sort:        "created",
-		ListOptions: github.ListOptions{PerPage: 100},
-	}
+	sem := make(chan struct{}, c.maxWorkers)
 
-	for {
-		result, resp, err := c.client.Search.Issues(c.ctx, query, opts)
-		if err != nil {
-			return nil, fmt.Errorf("failed to search PRs: %w", err)
-		}
+	for _, window := range windows {
+		wg.Add(1)
+		go func(w timeWindow) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
 
-		for _, issue := range result.Issues {
-			stats.Total++
+			dates, err := c.getContributionsForPeriod(username, w.From, w.To)
 
-			if issue.RepositoryURL != nil {
-				repoName := extractRepoName(*issue.RepositoryURL)
-				repoCount[repoName]++
-			}
+			mu.Lock()
+			defer mu.Unlock()
 
-			if issue.State != nil {
-				switch *issue.State {
-				case "open":
-					stats.Open++
-				case "closed":
-					stats.Closed++
+			if err != nil {
+				calendar.FailedYears = append(calendar.FailedYears, w.Year)
+				if firstErr == nil {
+					firstErr = err
 				}
+				return
 			}
-		}
 
-		if resp.NextPage == 0 {
-			break
-		}
-		opts.Page = resp.NextPage
-	}
-
-	mergedQuery := fmt.Sprintf("author:%s is:pr is:merged", username)
-	opts.Page = 0
-
-	for {
-		result, resp, err := c.client.Search.Issues(c.ctx, mergedQuery, opts)
-		if err != nil {
-			return nil, fmt.Errorf("failed to search merged PRs: %w", err)
-		}
-
-		for _, issue := range result.Issues {
-			stats.Merged++
-			if issue.CreatedAt != nil && issue.ClosedAt != nil {
-				mergeTime := issue.ClosedAt.Sub(issue.CreatedAt.Time)
-				mergeTimes = append(mergeTimes, mergeTime)
+			calendar.FetchedYears = append(calendar.FetchedYears, w.Year)
+			for _, date := range dates {
+				dateStr := date.Format("2006-01-02")
+				if !dateSet[dateStr] {
+					dateSet[dateStr] = true
+					calendar.Dates = append(calendar.Dates, date)
+				}
 			}
-		}
-
-		if resp.NextPage == 0 {
-			break
-		}
-		opts.Page = resp.NextPage
+		}(window)
 	}
 
-	stats.Closed = stats.Closed - stats.Merged
+	wg.Wait()
 
-	if len(mergeTimes) > 0 {
-		var total time.Duration
-		for _, t := range mergeTimes {
-			total += t
-		}
-		stats.AvgMergeTime = total / time.Duration(len(mergeTimes))
+	if len(calendar.FetchedYears) == 0 && firstErr != nil {
+		return nil, firstErr
 	}
 
-	stats.TopRepos = getTopRepos(repoCount, 5)
+	sort.Ints(calendar.FetchedYears)
+	sort.Ints(calendar.FailedYears)
 
-	return stats, nil
+	return calendar, nil
 }
 
-func (c *Client) GetUserIssues(username string) (*IssueStats, error) {
-	stats := &IssueStats{}
-	var closeTimes []time.Duration
-
-	query := fmt.Sprintf("author:%s is:issue", username)
-	opts := &github.SearchOptions{
-		Sort:        "created",
-		ListOptions: github.ListOptions{PerPage: 100},
-	}
-
-	for {
-		result, resp, err := c.client.Search.Issues(c.ctx, query, opts)
-		if err != nil {
-			return nil, fmt.Errorf("failed to search issues: %w", err)
-		}
+type contributionYearsResponse struct {
+	User struct {
+		ContributionsCollection struct {
+			ContributionYears []int `json:"contributionYears"`
+		} `json:"contributionsCollection"`
+	} `json:"user"`
+}
 
-		for _, issue := range result.Issues {
-			stats.Total++
-
-			if issue.State != nil {
-				switch *issue.State {
-				case "open":
-					stats.Open++
-				case "closed":
-					stats.Closed++
-					if issue.CreatedAt != nil && issue.ClosedAt != nil {
-						closeTime := issue.ClosedAt.Sub(issue.CreatedAt.Time)
-						closeTimes = append(closeTimes, closeTime)
-					}
+func (c *Client) getContributionYears(username string) ([]int, error) {
+	query := `
+		query($username: String!) {
+			user(login: $username) {
+				contributionsCollection {
+					contributionYears
 				}
 			}
 		}
+	`
 
-		if resp.NextPage == 0 {
-			break
-		}
-		opts.Page = resp.NextPage
+	variables := map[string]interface{}{
+		"username": username,
 	}
 
-	if len(closeTimes) > 0 {
-		var total time.Duration
-		for _, t := range closeTimes {
-			total += t
-		}
-		stats.AvgCloseTime = total / time.Duration(len(closeTimes))
+	var result contributionYearsResponse
+	if err := c.executeGraphQL(query, variables, &result); err != nil {
+		return nil, err
 	}
 
-	return stats, nil
+	return result.User.ContributionsCollection.ContributionYears, nil
 }
 
-type reviewContributionsResponse struct {
-	Data struct {
-		User struct {
-			ContributionsCollection struct {
-				PullRequestReviewContributions struct {
-					TotalCount int `json:"totalCount"`
-					Nodes      []struct {
-						PullRequest struct {
-							Repository struct {
-								NameWithOwner string `json:"nameWithOwner"`
-							} `json:"repository"`
-						} `json:"pullRequest"`
-					} `json:"nodes"`
-					PageInfo struct {
-						HasNextPage bool   `json:"hasNextPage"`
-						EndCursor   string `json:"endCursor"`
-					} `json:"pageInfo"`
-				} `json:"pullRequestReviewContributions"`
-			} `json:"contributionsCollection"`
-		} `json:"user"`
-	} `json:"data"`
-	Errors []struct {
-		Message string `json:"message"`
-	} `json:"errors"`
-}
+func (c *Client) getContributionsForPeriod(username string, from, to time.Time) ([]time.Time, error) {
+	days, err := c.getContributionDays(username, from, to)
+	if err != nil {
+		return nil, err
+	}
 
-func (c *Client) GetUserReviews(username string) (*ReviewStats, error) {
-	stats := &ReviewStats{
-		TopRepos: make([]RepoCount, 0),
+	var dates []time.Time
+	for _, day := range days {
+		if day.Count > 0 {
+			dates = append(dates, day.Date)
+		}
 	}
 
-	repoCount := make(map[string]int)
-	var cursor *string
+	return dates, nil
+}
 
-	for {
-		query := `
-			query($username: String!, $after: String) {
-				user(login: $username) {
-					contributionsCollection {
-						pullRequestReviewContributions(first: 100, after: $after) {
-							totalCount
-							nodes {
-								pullRequest {
-									repository {
-										nameWithOwner
-									}
-								}
-							}
-							pageInfo {
-								hasNextPage
-								endCursor
+func (c *Client) getContributionDays(username string, from, to time.Time) ([]ContributionDay, error) {
+	query := `
+		query($username: String!, $from: DateTime!, $to: DateTime!) {
+			user(login: $username) {
+				contributionsCollection(from: $from, to: $to) {
+					contributionCalendar {
+						totalContributions
+						weeks {
+							contributionDays {
+								date
+								contributionCount
 							}
 						}
 					}
 				}
 			}
-		`
-
-		variables := map[string]interface{}{
-			"username": username,
-		}
-		if cursor != nil {
-			variables["after"] = *cursor
-		}
-
-		reqBody := graphQLRequest{
-			Query:     query,
-			Variables: variables,
-		}
-
-		jsonBody, err := json.Marshal(reqBody)
-		if err != nil {
-			return nil, fmt.Errorf("failed to marshal request: %w", err)
-		}
-
-		req, err := http.NewRequestWithContext(c.ctx, "POST", graphQLEndpoint, bytes.NewBuffer(jsonBody))
-		if err != nil {
-			return nil, fmt.Errorf("failed to create request: %w", err)
-		}
-
-		req.Header.Set("Content-Type", "application/json")
-
-		resp, err := c.httpClient.Do(req)
-		if err != nil {
-			return nil, fmt.Errorf("failed to execute request: %w", err)
-		}
-
-		body, err := io.ReadAll(resp.Body)
-		_ = resp.Body.Close()
-		if err != nil {
-			return nil, fmt.Errorf("failed to read response: %w", err)
-		}
-
-		var result reviewContributionsResponse
-		if err := json.Unmarshal(body, &result); err != nil {
-			return nil, fmt.Errorf("failed to parse response: %w", err)
-		}
-
-		if len(result.Errors) > 0 {
-			return nil, fmt.Errorf("GraphQL error: %s", result.Errors[0].Message)
 		}
+	`
 
-		contributions := result.Data.User.ContributionsCollection.PullRequestReviewContributions
+	variables := map[string]interface{}{
+		"username": username,
+		"from":     from.Format(time.RFC3339),
+		"to":       to.Format(time.RFC3339),
+	}
 
-		if stats.Total == 0 {
-			stats.Total = contributions.TotalCount
-		}
+	var result contributionCalendarResponse
+	if err := c.executeGraphQL(query, variables, &result); err != nil {
+		return nil, err
+	}
 
-		for _, node := range contributions.Nodes {
-			repoName := node.PullRequest.Repository.NameWithOwner
-			repoCount[repoName]++
-		}
+	return parseContributionDays(result.User.ContributionsCollection.ContributionCalendar), nil
+}
 
-		if !contributions.PageInfo.HasNextPage {
-			break
+func parseContributionDays(calendar contributionCalendar) []ContributionDay {
+	var days []ContributionDay
+	for _, week := range calendar.Weeks {
+		for _, day := range week.ContributionDays {
+			date, err := time.Parse("2006-01-02", day.Date)
+			if err != nil {
+				continue
+			}
+			days = append(days, ContributionDay{Date: date, Count: day.ContributionCount})
 		}
-		cursor = &contributions.PageInfo.EndCursor
 	}
+	return days
+}
 
-	stats.TopRepos = getTopRepos(repoCount, 5)
-
-	return stats, nil
+func (c *Client) CheckRateLimit() (*github.RateLimits, error) {
+	limits, _, err := c.client.RateLimit.Get(c.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check rate limit: %w", err)
+	}
+	return limits, nil
 }
 
-func extractRepoName(repoURL string) string {
-	parts := strings.Split(repoURL, "/repos/")
-	if len(parts) == 2 {
-		return parts[1]
+func (c *Client) CountIssues(query string) (int, error) {
+	opts := &github.SearchOptions{
+		ListOptions: github.ListOptions{PerPage: 1},
+	}
+
+	result, _, err := c.client.Search.Issues(c.ctx, query, opts)
+	if err != nil {
+		return 0, fmt.Errorf("failed to search issues: %w", err)
+	}
+	if result.Total == nil {
+		return 0, nil
 	}
-	return repoURL
+	return *result.Total, nil
 }
 
 func getTopRepos(repoCount map[string]int, limit int) []RepoCount {
@@ -688,3 +577,22 @@ func getTopRepos(repoCount map[string]int, limit int) []RepoCount {
 	}
 	return repos
 }
+
+func getTopUsers(userCount map[string]int, limit int) []UserCount {
+	var users []UserCount
+	for login, count := range userCount {
+		users = append(users, UserCount{Login: login, Count: count})
+	}
+
+	sort.Slice(users, func(i, j int) bool {
+		if users[i].Count != users[j].Count {
+			return users[i].Count > users[j].Count
+		}
+		return users[i].Login < users[j].Login
+	})
+
+	if len(users) > limit {
+		return users[:limit]
+	}
+	return users
+}
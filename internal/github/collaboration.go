@@ -0,0 +1,66 @@
+package github
+
+import (
+	"sort"
+	"strings"
+)
+
+// buildCollaborators scores everyone the user works with: reviews in either
+// direction count once, co-authored PRs count double.
+func buildCollaborators(username string, prs []PullRequestDetail, reviews []ReviewDetail) []Collaborator {
+	byLogin := make(map[string]*Collaborator)
+	repos := make(map[string]map[string]bool)
+
+	get := func(login, repo string) *Collaborator {
+		key := strings.ToLower(login)
+		collaborator, ok := byLogin[key]
+		if !ok {
+			collaborator = &Collaborator{Login: login}
+			byLogin[key] = collaborator
+			repos[key] = make(map[string]bool)
+		}
+		repos[key][repo] = true
+		return collaborator
+	}
+
+	for _, pr := range prs {
+		for _, reviewer := range pr.Reviewers {
+			if strings.EqualFold(reviewer, username) {
+				continue
+			}
+			get(reviewer, pr.Repository).ReviewedByThem++
+		}
+		for _, coAuthor := range pr.CoAuthors {
+			if strings.EqualFold(coAuthor, username) {
+				continue
+			}
+			get(coAuthor, pr.Repository).CoAuthored++
+		}
+	}
+
+	for _, review := range reviews {
+		if review.PRAuthor == "" || strings.EqualFold(review.PRAuthor, username) {
+			continue
+		}
+		get(review.PRAuthor, review.Repository).ReviewedByUser++
+	}
+
+	collaborators := make([]Collaborator, 0, len(byLogin))
+	for key, collaborator := range byLogin {
+		for repo := range repos[key] {
+			collaborator.SharedRepos = append(collaborator.SharedRepos, repo)
+		}
+		sort.Strings(collaborator.SharedRepos)
+		collaborator.Score = collaborator.ReviewedByThem + collaborator.ReviewedByUser + 2*collaborator.CoAuthored
+		collaborators = append(collaborators, *collaborator)
+	}
+
+	sort.Slice(collaborators, func(i, j int) bool {
+		if collaborators[i].Score != collaborators[j].Score {
+			return collaborators[i].Score > collaborators[j].Score
+		}
+		return strings.ToLower(collaborators[i].Login) < strings.ToLower(collaborators[j].Login)
+	})
+
+	return collaborators
+}
@@ -0,0 +1,138 @@
+package github
+
+import (
+	"context"
+	"sync"
+)
+
+const (
+	MetricUnitCount = "count"
+	MetricUnitHours = "hours"
+)
+
+// calculateFanOut is how many sections Calculate fetches concurrently for a
+// single user, used to keep several users within the --workers budget.
+const calculateFanOut = 5
+
+func (s *StatsCalculator) CalculateComparison(ctx context.Context, usernames []string) (*Comparison, error) {
+	results := make([]*UserStats, len(usernames))
+	errs := make([]error, len(usernames))
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, max(1, s.client.maxWorkers/calculateFanOut))
+
+	for i, username := range usernames {
+		wg.Add(1)
+		go func(i int, username string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			results[i], errs[i] = s.Calculate(ctx, username)
+		}(i, username)
+	}
+
+	wg.Wait()
+
+	var stats []*UserStats
+	comparison := &Comparison{
+		Users:        make([]string, 0, len(usernames)),
+		TopLanguages: make([]string, 0, len(usernames)),
+		Failed:       make(map[string]string),
+	}
+	for i, username := range usernames {
+		if errs[i] != nil {
+			comparison.Failed[username] = errs[i].Error()
+			continue
+		}
+		stats = append(stats, results[i])
+		comparison.Users = append(comparison.Users, username)
+		comparison.TopLanguages = append(comparison.TopLanguages, topLanguage(results[i].Languages))
+	}
+
+	if len(stats) == 0 {
+		return nil, errs[0]
+	}
+
+	comparison.Metrics = compareMetrics(stats)
+	return comparison, nil
+}
+
+func compareMetrics(stats []*UserStats) []ComparisonMetric {
+	metrics := []struct {
+		name          string
+		unit          string
+		lowerIsBetter bool
+		value         func(*UserStats) float64
+	}{
+		{"Total Stars", MetricUnitCount, false, func(u *UserStats) float64 { return float64(u.TotalStars) }},
+		{"Followers", MetricUnitCount, false, func(u *UserStats) float64 { return float64(u.Followers) }},
+		{"Public Repos", MetricUnitCount, false, func(u *UserStats) float64 { return float64(u.PublicRepos) }},
+		{"Current Streak", MetricUnitCount, false, func(u *UserStats) float64 { return float64(u.CurrentStreak) }},
+		{"Longest Streak", MetricUnitCount, false, func(u *UserStats) float64 { return float64(u.MaxStreak) }},
+		{"PRs Merged", MetricUnitCount, false, func(u *UserStats) float64 {
+			if u.PRStats == nil {
+				return 0
+			}
+			return float64(u.PRStats.Merged)
+		}},
+		{"Reviews", MetricUnitCount, false, func(u *UserStats) float64 {
+			if u.ReviewStats == nil {
+				return 0
+			}
+			return float64(u.ReviewStats.Total)
+		}},
+		{"Avg Merge Time", MetricUnitHours, true, func(u *UserStats) float64 {
+			if u.PRStats == nil {
+				return 0
+			}
+			return u.PRStats.AvgMergeTime.Hours()
+		}},
+	}
+
+	var result []ComparisonMetric
+	for _, m := range metrics {
+		metric := ComparisonMetric{
+			Name:   m.name,
+			Unit:   m.unit,
+			Values: make([]float64, len(stats)),
+		}
+
+		leader := -1
+		tied := false
+		for i, u := range stats {
+			value := m.value(u)
+			metric.Values[i] = value
+			// A zero average merge time means no merged PRs, not an instant merge.
+			if m.lowerIsBetter && value == 0 {
+				continue
+			}
+			switch {
+			case leader == -1:
+				leader = i
+			case value == metric.Values[leader]:
+				tied = true
+			case (value < metric.Values[leader]) == m.lowerIsBetter:
+				leader = i
+				tied = false
+			}
+		}
+
+		if leader >= 0 && !tied && (m.lowerIsBetter || metric.Values[leader] > 0) {
+			metric.Leader = stats[leader].Username
+		}
+		result = append(result, metric)
+	}
+
+	return result
+}
+
+func topLanguage(languages map[string]int64) string {
+	top := ""
+	var topBytes int64
+	for lang, bytes := range languages {
+		if bytes > topBytes || (bytes == topBytes && lang < top) {
+			top = lang
+			topBytes = bytes
+		}
+	}
+	return top
+}
@@ -0,0 +1,154 @@
+package github
+
+import (
+	"time"
+)
+
+// maxCommitRepositories is the most repositories GitHub breaks a
+// contribution window's commits down by.
+const maxCommitRepositories = 100
+
+type commitContribution struct {
+	OccurredAt time.Time `json:"occurredAt"`
+}
+
+type contributionSummaryResponse struct {
+	User struct {
+		ContributionsCollection struct {
+			TotalCommitContributions            int                  `json:"totalCommitContributions"`
+			TotalIssueContributions             int                  `json:"totalIssueContributions"`
+			TotalPullRequestContributions       int                  `json:"totalPullRequestContributions"`
+			TotalPullRequestReviewContributions int                  `json:"totalPullRequestReviewContributions"`
+			RestrictedContributionsCount        int                  `json:"restrictedContributionsCount"`
+			ContributionCalendar                contributionCalendar `json:"contributionCalendar"`
+			CommitContributionsByRepository     []struct {
+				Repository struct {
+					NameWithOwner   string `json:"nameWithOwner"`
+					StargazerCount  int    `json:"stargazerCount"`
+					PrimaryLanguage *struct {
+						Name string `json:"name"`
+					} `json:"primaryLanguage"`
+				} `json:"repository"`
+				Contributions struct {
+					TotalCount int                  `json:"totalCount"`
+					Nodes      []commitContribution `json:"nodes"`
+				} `json:"contributions"`
+				Earliest struct {
+					Nodes []commitContribution `json:"nodes"`
+				} `json:"earliest"`
+			} `json:"commitContributionsByRepository"`
+		} `json:"contributionsCollection"`
+	} `json:"user"`
+}
+
+func (c *Client) GetContributionSummary(username string, from, to time.Time) (*ContributionSummary, error) {
+	query := `
+		query($username: String!, $from: DateTime!, $to: DateTime!) {
+			user(login: $username) {
+				contributionsCollection(from: $from, to: $to) {
+					totalCommitContributions
+					totalIssueContributions
+					totalPullRequestContributions
+					totalPullRequestReviewContributions
+					restrictedContributionsCount
+					contributionCalendar {
+						totalContributions
+						weeks {
+							contributionDays {
+								date
+								contributionCount
+							}
+						}
+					}
+					commitContributionsByRepository(maxRepositories: 100) {
+						repository {
+							nameWithOwner
+							stargazerCount
+							primaryLanguage {
+								name
+							}
+						}
+						contributions(first: 1) {
+							totalCount
+							nodes {
+								occurredAt
+							}
+						}
+						earliest: contributions(first: 1, orderBy: {field: OCCURRED_AT, direction: ASC}) {
+							nodes {
+								occurredAt
+							}
+						}
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"username": username,
+		"from":     from.Format(time.RFC3339),
+		"to":       to.Format(time.RFC3339),
+	}
+
+	var result contributionSummaryResponse
+	if err := c.executeGraphQL(query, variables, &result); err != nil {
+		return nil, err
+	}
+
+	collection := result.User.ContributionsCollection
+	summary := &ContributionSummary{
+		From:               from,
+		To:                 to,
+		TotalContributions: collection.ContributionCalendar.TotalContributions,
+		Commits:            collection.TotalCommitContributions,
+		Issues:             collection.TotalIssueContributions,
+		PullRequests:       collection.TotalPullRequestContributions,
+		Reviews:            collection.TotalPullRequestReviewContributions,
+		Restricted:         collection.RestrictedContributionsCount,
+		Days:               parseContributionDays(collection.ContributionCalendar),
+	}
+
+	for _, repo := range collection.CommitContributionsByRepository {
+		contribution := RepoContribution{
+			RepoName: repo.Repository.NameWithOwner,
+			Stars:    repo.Repository.StargazerCount,
+			Commits:  repo.Contributions.TotalCount,
+		}
+		for _, node := range repo.Contributions.Nodes {
+			contribution.LastCommit = node.OccurredAt
+		}
+		for _, node := range repo.Earliest.Nodes {
+			contribution.FirstCommit = node.OccurredAt
+		}
+		if repo.Repository.PrimaryLanguage != nil {
+			contribution.Language = repo.Repository.PrimaryLanguage.Name
+		}
+		summary.Repositories = append(summary.Repositories, contribution)
+	}
+	summary.RepositoriesTruncated = len(collection.CommitContributionsByRepository) >= maxCommitRepositories
+
+	return summary, nil
+}
+
+type timeWindow struct {
+	Year int
+	From time.Time
+	To   time.Time
+}
+
+func yearWindows(since, until time.Time) []timeWindow {
+	var windows []timeWindow
+	for year := until.Year(); year >= since.Year(); year-- {
+		from := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+		to := from.AddDate(1, 0, 0).Add(-time.Second)
+		if from.Before(since) {
+			from = since
+		}
+		if to.After(until) {
+			to = until
+		}
+		windows = append(windows, timeWindow{Year: year, From: from, To: to})
+	}
+	return windows
+}
@@ -0,0 +1,136 @@
+package github
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// GetCommitContributionsByRepo sums commit contributions per repository
+// since the given time. GitHub only breaks each year down by its top
+// repositories, so it also returns how many commits fell outside them.
+func (c *Client) GetCommitContributionsByRepo(username string, since time.Time) ([]RepoContribution, int, error) {
+	byRepo := make(map[string]*RepoContribution)
+	unattributed := 0
+
+	for _, window := range yearWindows(since.UTC(), time.Now().UTC()) {
+		summary, err := c.GetContributionSummary(username, window.From, window.To)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to get commit contributions for %d: %w", window.Year, err)
+		}
+
+		if summary.RepositoriesTruncated {
+			attributed := 0
+			for _, repo := range summary.Repositories {
+				attributed += repo.Commits
+			}
+			unattributed += max(0, summary.Commits-attributed)
+		}
+
+		for _, repo := range summary.Repositories {
+			existing, ok := byRepo[repo.RepoName]
+			if !ok {
+				contribution := repo
+				byRepo[repo.RepoName] = &contribution
+				continue
+			}
+			existing.Commits += repo.Commits
+			existing.FirstCommit = earliest(existing.FirstCommit, repo.FirstCommit)
+			existing.LastCommit = latest(existing.LastCommit, repo.LastCommit)
+		}
+	}
+
+	var repos []RepoContribution
+	for _, repo := range byRepo {
+		repos = append(repos, *repo)
+	}
+	return repos, unattributed, nil
+}
+
+func isOwnRepo(fullName, username string) bool {
+	owner, _, _ := strings.Cut(fullName, "/")
+	return strings.EqualFold(owner, username)
+}
+
+// buildExternalContributions ranks repositories the user does not own by
+// impact: merged PRs weigh most, then reviews, then commits, scaled by the
+// log of the repository's stars so popular projects rank higher.
+func buildExternalContributions(username string, prs []PullRequestDetail, reviews []ReviewDetail, commits []RepoContribution) []ExternalContribution {
+	byRepo := make(map[string]*ExternalContribution)
+	get := func(name string, stars int) *ExternalContribution {
+		contribution, ok := byRepo[name]
+		if !ok {
+			contribution = &ExternalContribution{Repository: name}
+			byRepo[name] = contribution
+		}
+		if stars > contribution.Stars {
+			contribution.Stars = stars
+		}
+		return contribution
+	}
+
+	for _, pr := range prs {
+		if pr.State != "MERGED" || isOwnRepo(pr.Repository, username) {
+			continue
+		}
+		contribution := get(pr.Repository, pr.RepoStars)
+		contribution.MergedPRs++
+		contribution.touch(pr.MergedAt)
+	}
+
+	for _, review := range reviews {
+		if isOwnRepo(review.Repository, username) {
+			continue
+		}
+		contribution := get(review.Repository, review.RepoStars)
+		contribution.Reviews++
+		contribution.touch(review.SubmittedAt)
+	}
+
+	for _, repo := range commits {
+		if isOwnRepo(repo.RepoName, username) {
+			continue
+		}
+		contribution := get(repo.RepoName, repo.Stars)
+		contribution.Commits += repo.Commits
+		contribution.touch(repo.FirstCommit)
+		contribution.touch(repo.LastCommit)
+	}
+
+	result := make([]ExternalContribution, 0, len(byRepo))
+	for _, contribution := range byRepo {
+		activity := float64(contribution.MergedPRs)*3 + float64(contribution.Reviews) + float64(contribution.Commits)*0.5
+		contribution.Impact = activity * math.Log10(float64(contribution.Stars)+10)
+		result = append(result, *contribution)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Impact != result[j].Impact {
+			return result[i].Impact > result[j].Impact
+		}
+		return result[i].Repository < result[j].Repository
+	})
+
+	return result
+}
+
+func (e *ExternalContribution) touch(t time.Time) {
+	e.FirstContribution = earliest(e.FirstContribution, t)
+	e.LastContribution = latest(e.LastContribution, t)
+}
+
+func earliest(a, b time.Time) time.Time {
+	if a.IsZero() || (!b.IsZero() && b.Before(a)) {
+		return b
+	}
+	return a
+}
+
+func latest(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
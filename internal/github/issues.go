@@ -0,0 +1,267 @@
+package github
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+type issueSearchResponse struct {
+	Search struct {
+		Nodes    []issueNode `json:"nodes"`
+		PageInfo pageInfo    `json:"pageInfo"`
+	} `json:"search"`
+}
+
+type issueNode struct {
+	Number      int        `json:"number"`
+	Title       string     `json:"title"`
+	URL         string     `json:"url"`
+	State       string     `json:"state"`
+	StateReason string     `json:"stateReason"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	ClosedAt    *time.Time `json:"closedAt"`
+	Author      *struct {
+		Login string `json:"login"`
+	} `json:"author"`
+	Repository struct {
+		NameWithOwner string `json:"nameWithOwner"`
+	} `json:"repository"`
+	Labels struct {
+		Nodes []struct {
+			Name string `json:"name"`
+		} `json:"nodes"`
+	} `json:"labels"`
+	TimelineItems struct {
+		Nodes []struct {
+			Actor *struct {
+				Login string `json:"login"`
+			} `json:"actor"`
+		} `json:"nodes"`
+	} `json:"timelineItems"`
+	ClosedByPullRequestsReferences struct {
+		TotalCount int `json:"totalCount"`
+	} `json:"closedByPullRequestsReferences"`
+	Comments struct {
+		Nodes []struct {
+			CreatedAt time.Time `json:"createdAt"`
+			Author    *struct {
+				Login string `json:"login"`
+			} `json:"author"`
+		} `json:"nodes"`
+	} `json:"comments"`
+}
+
+func (c *Client) GetUserIssues(username string) (*IssueStats, error) {
+	details, err := c.GetIssueDetails(username)
+	if err != nil {
+		return nil, err
+	}
+	return summarizeIssues(details), nil
+}
+
+func (c *Client) GetIssueDetails(username string) ([]IssueDetail, error) {
+	if c.hasState() {
+		return c.incrementalIssues(username)
+	}
+	return c.SearchIssues(fmt.Sprintf("author:%s is:issue sort:created-desc", username))
+}
+
+func (c *Client) SearchIssues(search string) ([]IssueDetail, error) {
+	query := `
+		query($query: String!, $after: String) {
+			search(query: $query, type: ISSUE, first: 50, after: $after) {
+				nodes {
+					... on Issue {
+						number
+						title
+						url
+						state
+						stateReason
+						createdAt
+						closedAt
+						author {
+							login
+						}
+						repository {
+							nameWithOwner
+						}
+						labels(first: 20) {
+							nodes {
+								name
+							}
+						}
+						timelineItems(itemTypes: [CLOSED_EVENT], last: 1) {
+							nodes {
+								... on ClosedEvent {
+									actor {
+										login
+									}
+								}
+							}
+						}
+						closedByPullRequestsReferences(first: 1, includeClosedPrs: true) {
+							totalCount
+						}
+						comments(first: 10) {
+							nodes {
+								createdAt
+								author {
+									login
+								}
+							}
+						}
+					}
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	`
+
+	var details []IssueDetail
+	var cursor *string
+
+	for {
+		variables := map[string]interface{}{
+			"query": search,
+		}
+		if cursor != nil {
+			variables["after"] = *cursor
+		}
+
+		var result issueSearchResponse
+		if err := c.executeGraphQL(query, variables, &result); err != nil {
+			return nil, fmt.Errorf("failed to search issues: %w", err)
+		}
+
+		for _, node := range result.Search.Nodes {
+			details = append(details, node.toDetail())
+		}
+
+		if !result.Search.PageInfo.HasNextPage {
+			break
+		}
+		cursor = &result.Search.PageInfo.EndCursor
+	}
+
+	return details, nil
+}
+
+func (n issueNode) toDetail() IssueDetail {
+	detail := IssueDetail{
+		Repository:  n.Repository.NameWithOwner,
+		Number:      n.Number,
+		Title:       n.Title,
+		URL:         n.URL,
+		State:       n.State,
+		StateReason: n.StateReason,
+		CreatedAt:   n.CreatedAt,
+		LinkedPRs:   n.ClosedByPullRequestsReferences.TotalCount,
+	}
+	if n.ClosedAt != nil {
+		detail.ClosedAt = *n.ClosedAt
+	}
+	if n.Author != nil {
+		detail.Author = n.Author.Login
+	}
+	for _, event := range n.TimelineItems.Nodes {
+		if event.Actor != nil {
+			detail.ClosedBy = event.Actor.Login
+		}
+	}
+	for _, label := range n.Labels.Nodes {
+		detail.Labels = append(detail.Labels, label.Name)
+	}
+	for _, comment := range n.Comments.Nodes {
+		if comment.Author == nil || comment.Author.Login == detail.Author {
+			continue
+		}
+		if detail.FirstResponseAt.IsZero() || comment.CreatedAt.Before(detail.FirstResponseAt) {
+			detail.FirstResponseAt = comment.CreatedAt
+		}
+	}
+	return detail
+}
+
+func summarizeIssues(details []IssueDetail) *IssueStats {
+	stats := &IssueStats{
+		TopRepos:  make([]RepoCount, 0),
+		TopLabels: make([]LabelCount, 0),
+	}
+
+	repoCount := make(map[string]int)
+	labelCount := make(map[string]int)
+	var closeTimes []time.Duration
+
+	for _, issue := range details {
+		stats.Total++
+		repoCount[issue.Repository]++
+
+		for _, label := range issue.Labels {
+			labelCount[strings.ToLower(label)]++
+		}
+
+		if issue.LinkedPRs > 0 {
+			stats.IssuesWithLinkedPRs++
+			stats.LinkedPRs += issue.LinkedPRs
+		}
+
+		switch issue.State {
+		case "OPEN":
+			stats.Open++
+		case "CLOSED":
+			stats.Closed++
+			if !issue.ClosedAt.IsZero() {
+				closeTimes = append(closeTimes, issue.ClosedAt.Sub(issue.CreatedAt))
+			}
+
+			switch issue.StateReason {
+			case "COMPLETED":
+				stats.Completed++
+			case "NOT_PLANNED":
+				stats.NotPlanned++
+			case "DUPLICATE":
+				stats.Duplicate++
+			}
+
+			if issue.ClosedBy != "" {
+				if strings.EqualFold(issue.ClosedBy, issue.Author) {
+					stats.ClosedByAuthor++
+				} else {
+					stats.ClosedByOthers++
+				}
+			}
+		}
+	}
+
+	stats.CloseTime = summarizeDurations(closeTimes)
+	stats.CloseTimeHistogram = bucketDurations(closeTimes)
+	stats.AvgCloseTime = stats.CloseTime.Mean
+	stats.TopRepos = getTopRepos(repoCount, 5)
+	stats.TopLabels = getTopLabels(labelCount, 10)
+
+	return stats
+}
+
+func getTopLabels(labelCount map[string]int, limit int) []LabelCount {
+	var labels []LabelCount
+	for name, count := range labelCount {
+		labels = append(labels, LabelCount{Name: name, Count: count})
+	}
+
+	sort.Slice(labels, func(i, j int) bool {
+		if labels[i].Count != labels[j].Count {
+			return labels[i].Count > labels[j].Count
+		}
+		return labels[i].Name < labels[j].Name
+	})
+
+	if len(labels) > limit {
+		return labels[:limit]
+	}
+	return labels
+}
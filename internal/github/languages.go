@@ -0,0 +1,285 @@
+package github
+
+import (
+	"fmt"
+	"math"
+	"path"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/v81/github"
+)
+
+const (
+	LanguageWeightBytes = "bytes"
+	LanguageWeightRepos = "repos"
+
+	TimelineByCreated = "created"
+	TimelineByPushed  = "pushed"
+	TimelineByCommits = "commits"
+
+	timelineLanguages = 6
+)
+
+type LanguageOptions struct {
+	IncludeForks     bool
+	ExcludeLanguages []string
+	ExcludeRepos     []string
+	RepoCap          int64
+	Weighting        string
+	TimelineBy       string
+}
+
+func (c *Client) GetLanguages(repos []*github.Repository, opts LanguageOptions) (map[string]map[string]int64, error) {
+	return c.GetRepoLanguages(filterLanguageRepos(repos, opts))
+}
+
+func (c *Client) GetRepoLanguages(repos []*github.Repository) (map[string]map[string]int64, error) {
+	repoLanguages := make(map[string]map[string]int64)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	sem := make(chan struct{}, c.maxWorkers)
+	errChan := make(chan error, len(repos))
+
+	for _, repo := range repos {
+		wg.Add(1)
+		go func(r *github.Repository) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			c.cacheMu.Lock()
+			cached, ok := c.languageCache[r.GetFullName()]
+			c.cacheMu.Unlock()
+			if ok {
+				mu.Lock()
+				repoLanguages[r.GetFullName()] = cached
+				mu.Unlock()
+				return
+			}
+			if stored, ok := c.cachedRepoLanguages(r); ok {
+				mu.Lock()
+				repoLanguages[r.GetFullName()] = stored
+				mu.Unlock()
+				return
+			}
+
+			langs, _, err := c.client.Repositories.ListLanguages(c.ctx,
+				*r.Owner.Login, *r.Name)
+			if err != nil {
+				errChan <- fmt.Errorf("failed to get languages for %s: %w", *r.Name, err)
+				return
+			}
+
+			bytesByLang := make(map[string]int64, len(langs))
+			for lang, bytes := range langs {
+				bytesByLang[lang] = int64(bytes)
+			}
+
+			c.cacheMu.Lock()
+			c.languageCache[r.GetFullName()] = bytesByLang
+			c.cacheMu.Unlock()
+			c.storeRepoLanguages(r, bytesByLang)
+
+			mu.Lock()
+			repoLanguages[r.GetFullName()] = bytesByLang
+			mu.Unlock()
+		}(repo)
+	}
+
+	wg.Wait()
+	close(errChan)
+
+	var firstErr error
+	for err := range errChan {
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return repoLanguages, firstErr
+}
+
+func filterLanguageRepos(repos []*github.Repository, opts LanguageOptions) []*github.Repository {
+	var filtered []*github.Repository
+	for _, repo := range repos {
+		if repo.GetFork() && !opts.IncludeForks {
+			continue
+		}
+		if matchesAnyGlob(opts.ExcludeRepos, repo.GetName(), repo.GetFullName()) {
+			continue
+		}
+		filtered = append(filtered, repo)
+	}
+	return filtered
+}
+
+func aggregateLanguages(repoLanguages map[string]map[string]int64, opts LanguageOptions) map[string]int64 {
+	languages := make(map[string]int64)
+	for _, langs := range repoLanguages {
+		for lang, weight := range repoLanguageWeights(langs, opts) {
+			languages[lang] += weight
+		}
+	}
+	return languages
+}
+
+func repoLanguageWeights(langs map[string]int64, opts LanguageOptions) map[string]int64 {
+	kept := make(map[string]int64)
+	var repoTotal int64
+	for lang, bytes := range langs {
+		if containsFold(opts.ExcludeLanguages, lang) {
+			continue
+		}
+		kept[lang] = bytes
+		repoTotal += bytes
+	}
+
+	weights := make(map[string]int64, len(kept))
+	for lang, bytes := range kept {
+		switch {
+		case opts.Weighting == LanguageWeightRepos:
+			weights[lang] = 1
+		case opts.RepoCap > 0 && repoTotal > opts.RepoCap:
+			weights[lang] = bytes * opts.RepoCap / repoTotal
+		default:
+			weights[lang] = bytes
+		}
+	}
+	return weights
+}
+
+func buildLanguageTimeline(repos []*github.Repository, repoLanguages map[string]map[string]int64,
+	repoCommits map[string][]time.Time, opts LanguageOptions) *LanguageTimeline {
+	bucketBy := opts.TimelineBy
+	if bucketBy == "" {
+		bucketBy = TimelineByCreated
+	}
+	if len(repoCommits) > 0 {
+		bucketBy = TimelineByCommits
+	}
+
+	yearWeights := make(map[int]map[string]float64)
+	totals := make(map[string]float64)
+	add := func(year int, lang string, weight float64) {
+		if yearWeights[year] == nil {
+			yearWeights[year] = make(map[string]float64)
+		}
+		yearWeights[year][lang] += weight
+		totals[lang] += weight
+	}
+
+	for _, repo := range repos {
+		weights := repoLanguageWeights(repoLanguages[repo.GetFullName()], opts)
+		if len(weights) == 0 {
+			continue
+		}
+
+		switch bucketBy {
+		case TimelineByCommits:
+			var repoTotal int64
+			for _, weight := range weights {
+				repoTotal += weight
+			}
+			commitsByYear := make(map[int]int)
+			for _, date := range repoCommits[repo.GetFullName()] {
+				commitsByYear[date.Year()]++
+			}
+			for year, commits := range commitsByYear {
+				for lang, weight := range weights {
+					add(year, lang, float64(commits)*float64(weight)/float64(repoTotal))
+				}
+			}
+		default:
+			var at time.Time
+			if bucketBy == TimelineByPushed {
+				at = repo.GetPushedAt().Time
+			} else {
+				at = repo.GetCreatedAt().Time
+			}
+			if at.IsZero() {
+				continue
+			}
+			for lang, weight := range weights {
+				add(at.Year(), lang, float64(weight))
+			}
+		}
+	}
+
+	if len(yearWeights) == 0 {
+		return nil
+	}
+
+	var languages []string
+	for lang := range totals {
+		languages = append(languages, lang)
+	}
+	sort.Slice(languages, func(i, j int) bool {
+		return totals[languages[i]] > totals[languages[j]]
+	})
+
+	hasOther := len(languages) > timelineLanguages
+	if hasOther {
+		languages = append(languages[:timelineLanguages], "Other")
+	}
+
+	var years []int
+	for year := range yearWeights {
+		years = append(years, year)
+	}
+	sort.Ints(years)
+
+	timeline := &LanguageTimeline{
+		BucketBy:  bucketBy,
+		Years:     years,
+		Languages: languages,
+	}
+
+	for _, lang := range languages {
+		series := LanguageSeries{Language: lang}
+		for _, year := range years {
+			var yearTotal, weight float64
+			for l, w := range yearWeights[year] {
+				yearTotal += w
+				if l == lang || (lang == "Other" && hasOther && !slices.Contains(languages, l)) {
+					weight += w
+				}
+			}
+			share := 0.0
+			if yearTotal > 0 {
+				share = weight / yearTotal * 100.0
+			}
+			series.Points = append(series.Points, YearShare{
+				Year:   year,
+				Weight: int64(math.Round(weight)),
+				Share:  share,
+			})
+		}
+		timeline.Series = append(timeline.Series, series)
+	}
+
+	return timeline
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesAnyGlob(patterns []string, names ...string) bool {
+	for _, pattern := range patterns {
+		for _, name := range names {
+			if matched, _ := path.Match(pattern, name); matched {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,217 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	MetricContributions = "contributions"
+	MetricCommits       = "commits"
+	MetricPRs           = "prs"
+	MetricPRsMerged     = "prs_merged"
+	MetricReviews       = "reviews"
+	MetricIssues        = "issues"
+)
+
+var LeaderboardMetrics = []string{
+	MetricContributions,
+	MetricCommits,
+	MetricPRs,
+	MetricPRsMerged,
+	MetricReviews,
+	MetricIssues,
+}
+
+type ScoreTerm struct {
+	Metric string
+	Weight float64
+}
+
+func ParseScoreFormula(expr string) ([]ScoreTerm, error) {
+	var terms []ScoreTerm
+	for _, part := range strings.Split(expr, "+") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			return nil, fmt.Errorf("invalid metric formula: %s", expr)
+		}
+
+		term := ScoreTerm{Metric: part, Weight: 1}
+		if left, right, ok := strings.Cut(part, "*"); ok {
+			left, right = strings.TrimSpace(left), strings.TrimSpace(right)
+			if weight, err := strconv.ParseFloat(left, 64); err == nil {
+				term = ScoreTerm{Metric: right, Weight: weight}
+			} else if weight, err := strconv.ParseFloat(right, 64); err == nil {
+				term = ScoreTerm{Metric: left, Weight: weight}
+			} else {
+				return nil, fmt.Errorf("invalid metric term: %s (expected metric*weight)", part)
+			}
+		}
+
+		if !slices.Contains(LeaderboardMetrics, term.Metric) {
+			return nil, fmt.Errorf("unknown metric: %s (must be one of: %s)", term.Metric, strings.Join(LeaderboardMetrics, ", "))
+		}
+		terms = append(terms, term)
+	}
+	return terms, nil
+}
+
+func (s *StatsCalculator) CalculateLeaderboard(ctx context.Context, usernames []string, metric string, since time.Time) (*Leaderboard, error) {
+	terms, err := ParseScoreFormula(metric)
+	if err != nil {
+		return nil, err
+	}
+
+	needsMerged := false
+	for _, term := range terms {
+		if term.Metric == MetricPRsMerged {
+			needsMerged = true
+		}
+	}
+
+	board := &Leaderboard{
+		Metric:  metric,
+		Since:   since,
+		Entries: make([]LeaderboardEntry, 0, len(usernames)),
+		Failed:  make(map[string]string),
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	sem := make(chan struct{}, s.client.maxWorkers)
+
+	for _, login := range usernames {
+		wg.Add(1)
+		go func(login string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			values, err := s.leaderboardValues(ctx, login, since, needsMerged)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				board.Failed[login] = err.Error()
+				return
+			}
+			entry := LeaderboardEntry{Login: login, Values: values}
+			for _, term := range terms {
+				entry.Score += values[term.Metric] * term.Weight
+			}
+			board.Entries = append(board.Entries, entry)
+		}(login)
+	}
+
+	wg.Wait()
+
+	if len(board.Entries) == 0 {
+		return nil, fmt.Errorf("failed to calculate metrics for any user")
+	}
+
+	rankLeaderboard(board.Entries)
+	return board, nil
+}
+
+func (s *StatsCalculator) leaderboardValues(ctx context.Context, username string, since time.Time, needsMerged bool) (map[string]float64, error) {
+	member, err := s.CalculateMember(ctx, username, since)
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[string]float64{
+		MetricContributions: float64(member.TotalContributions),
+		MetricCommits:       float64(member.Commits),
+		MetricPRs:           float64(member.PullRequests),
+		MetricReviews:       float64(member.Reviews),
+		MetricIssues:        float64(member.Issues),
+	}
+
+	if needsMerged {
+		merged, err := s.client.CountIssues(fmt.Sprintf("author:%s is:pr is:merged merged:>=%s", username, since.Format("2006-01-02")))
+		if err != nil {
+			return nil, err
+		}
+		values[MetricPRsMerged] = float64(merged)
+	}
+
+	return values, nil
+}
+
+// rankLeaderboard sorts entries by score and assigns competition ranks, so
+// tied users share a rank and the next rank skips accordingly (1, 1, 3).
+func rankLeaderboard(entries []LeaderboardEntry) {
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Score != entries[j].Score {
+			return entries[i].Score > entries[j].Score
+		}
+		return strings.ToLower(entries[i].Login) < strings.ToLower(entries[j].Login)
+	})
+
+	for i := range entries {
+		if i > 0 && entries[i].Score == entries[i-1].Score {
+			entries[i].Rank = entries[i-1].Rank
+			entries[i].Tied = true
+			entries[i-1].Tied = true
+			continue
+		}
+		entries[i].Rank = i + 1
+	}
+}
+
+// ComparePrevious records each entry's rank change since previous. Ranks
+// under a different metric are not comparable, so that is an error and
+// leaves the board unchanged.
+func (l *Leaderboard) ComparePrevious(previous *Leaderboard) error {
+	if !sameMetric(l.Metric, previous.Metric) {
+		return fmt.Errorf("previous leaderboard ranks by %q, not %q", previous.Metric, l.Metric)
+	}
+
+	ranks := make(map[string]int)
+	for _, entry := range previous.Entries {
+		ranks[strings.ToLower(entry.Login)] = entry.Rank
+	}
+
+	l.HasPrevious = true
+	for i := range l.Entries {
+		entry := &l.Entries[i]
+		prev, ok := ranks[strings.ToLower(entry.Login)]
+		if !ok {
+			entry.New = true
+			continue
+		}
+		entry.PreviousRank = prev
+		entry.RankChange = prev - entry.Rank
+	}
+	return nil
+}
+
+// sameMetric reports whether two metric formulas score users identically,
+// ignoring spacing and the order of their terms.
+func sameMetric(a, b string) bool {
+	termsA, errA := ParseScoreFormula(a)
+	termsB, errB := ParseScoreFormula(b)
+	if errA != nil || errB != nil {
+		return strings.TrimSpace(a) == strings.TrimSpace(b)
+	}
+
+	weights := make(map[string]float64)
+	for _, term := range termsA {
+		weights[term.Metric] += term.Weight
+	}
+	for _, term := range termsB {
+		weights[term.Metric] -= term.Weight
+	}
+	for _, weight := range weights {
+		if weight != 0 {
+			return false
+		}
+	}
+	return true
+}
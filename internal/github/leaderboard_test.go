@@ -0,0 +1,157 @@
+package github
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseScoreFormula(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		want    []ScoreTerm
+		wantErr bool
+	}{
+		{"single metric", "commits", []ScoreTerm{{MetricCommits, 1}}, false},
+		{"weight first", "3*prs_merged", []ScoreTerm{{MetricPRsMerged, 3}}, false},
+		{"weight last", "reviews*0.5", []ScoreTerm{{MetricReviews, 0.5}}, false},
+		{
+			name: "weighted sum with spaces",
+			expr: "prs_merged * 3 + 2*reviews + issues",
+			want: []ScoreTerm{{MetricPRsMerged, 3}, {MetricReviews, 2}, {MetricIssues, 1}},
+		},
+		{"empty", "", nil, true},
+		{"trailing plus", "commits+", nil, true},
+		{"unknown metric", "stars", nil, true},
+		{"unknown weighted metric", "2*stars", nil, true},
+		{"no numeric weight", "commits*reviews", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseScoreFormula(tt.expr)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseScoreFormula(%q) error = %v, wantErr %v", tt.expr, err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseScoreFormula(%q) = %v, want %v", tt.expr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRankLeaderboard(t *testing.T) {
+	type ranked struct {
+		Login string
+		Rank  int
+		Tied  bool
+	}
+
+	tests := []struct {
+		name    string
+		entries []LeaderboardEntry
+		want    []ranked
+	}{
+		{
+			name:    "distinct scores",
+			entries: []LeaderboardEntry{{Login: "b", Score: 1}, {Login: "a", Score: 3}, {Login: "c", Score: 2}},
+			want:    []ranked{{"a", 1, false}, {"c", 2, false}, {"b", 3, false}},
+		},
+		{
+			name:    "tie at the top skips the next rank",
+			entries: []LeaderboardEntry{{Login: "carol", Score: 5}, {Login: "Bob", Score: 9}, {Login: "alice", Score: 9}},
+			want:    []ranked{{"alice", 1, true}, {"Bob", 1, true}, {"carol", 3, false}},
+		},
+		{
+			name:    "three-way tie in the middle",
+			entries: []LeaderboardEntry{{Login: "a", Score: 10}, {Login: "b", Score: 4}, {Login: "c", Score: 4}, {Login: "d", Score: 4}, {Login: "e", Score: 1}},
+			want:    []ranked{{"a", 1, false}, {"b", 2, true}, {"c", 2, true}, {"d", 2, true}, {"e", 5, false}},
+		},
+		{
+			name:    "everyone tied",
+			entries: []LeaderboardEntry{{Login: "y", Score: 0}, {Login: "x", Score: 0}},
+			want:    []ranked{{"x", 1, true}, {"y", 1, true}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rankLeaderboard(tt.entries)
+			got := make([]ranked, len(tt.entries))
+			for i, entry := range tt.entries {
+				got[i] = ranked{entry.Login, entry.Rank, entry.Tied}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("rankLeaderboard() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestComparePrevious(t *testing.T) {
+	previous := &Leaderboard{Entries: []LeaderboardEntry{
+		{Login: "alice", Rank: 1},
+		{Login: "Bob", Rank: 2},
+		{Login: "carol", Rank: 3},
+	}}
+	current := &Leaderboard{Entries: []LeaderboardEntry{
+		{Login: "carol", Rank: 1},
+		{Login: "bob", Rank: 2},
+		{Login: "dave", Rank: 3},
+		{Login: "alice", Rank: 4},
+	}}
+
+	if err := current.ComparePrevious(previous); err != nil {
+		t.Fatal(err)
+	}
+
+	if !current.HasPrevious {
+		t.Error("HasPrevious = false, want true")
+	}
+
+	want := []struct {
+		PreviousRank int
+		RankChange   int
+		New          bool
+	}{
+		{3, 2, false},
+		{2, 0, false},
+		{0, 0, true},
+		{1, -3, false},
+	}
+	for i, w := range want {
+		entry := current.Entries[i]
+		if entry.PreviousRank != w.PreviousRank || entry.RankChange != w.RankChange || entry.New != w.New {
+			t.Errorf("%s: previous=%d change=%d new=%v, want previous=%d change=%d new=%v",
+				entry.Login, entry.PreviousRank, entry.RankChange, entry.New, w.PreviousRank, w.RankChange, w.New)
+		}
+	}
+}
+
+func TestComparePreviousRequiresSameMetric(t *testing.T) {
+	tests := []struct {
+		current  string
+		previous string
+		wantErr  bool
+	}{
+		{"commits", "commits", false},
+		{"prs_merged*3 + reviews", "reviews+3*prs_merged", false},
+		{"commits", "prs_merged", true},
+		{"prs_merged*3+reviews", "prs_merged*2+reviews", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.current+" vs "+tt.previous, func(t *testing.T) {
+			previous := &Leaderboard{Metric: tt.previous, Entries: []LeaderboardEntry{{Login: "alice", Rank: 2}}}
+			current := &Leaderboard{Metric: tt.current, Entries: []LeaderboardEntry{{Login: "alice", Rank: 1}}}
+
+			err := current.ComparePrevious(previous)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ComparePrevious() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if current.HasPrevious == tt.wantErr || (tt.wantErr && current.Entries[0].RankChange != 0) {
+				t.Errorf("HasPrevious = %v, RankChange = %d after error = %v", current.HasPrevious, current.Entries[0].RankChange, err)
+			}
+		})
+	}
+}
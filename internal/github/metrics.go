@@ -0,0 +1,111 @@
+package github
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+var durationBuckets = []struct {
+	label string
+	limit time.Duration
+}{
+	{"<1h", time.Hour},
+	{"<1d", 24 * time.Hour},
+	{"<1w", 7 * 24 * time.Hour},
+	{"<1m", 30 * 24 * time.Hour},
+	{"longer", 0},
+}
+
+func summarizeDurations(durations []time.Duration) DurationStats {
+	if len(durations) == 0 {
+		return DurationStats{}
+	}
+
+	sorted := make([]time.Duration, len(durations))
+	copy(sorted, durations)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+
+	var total time.Duration
+	for _, d := range sorted {
+		total += d
+	}
+
+	return DurationStats{
+		Count:  len(sorted),
+		Mean:   total / time.Duration(len(sorted)),
+		Median: percentile(sorted, 50),
+		P75:    percentile(sorted, 75),
+		P90:    percentile(sorted, 90),
+		Min:    sorted[0],
+		Max:    sorted[len(sorted)-1],
+	}
+}
+
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	if len(sorted) == 1 {
+		return sorted[0]
+	}
+
+	rank := p / 100.0 * float64(len(sorted)-1)
+	lower := int(rank)
+	upper := lower + 1
+	if upper >= len(sorted) {
+		return sorted[lower]
+	}
+
+	weight := rank - float64(lower)
+	return sorted[lower] + time.Duration(weight*float64(sorted[upper]-sorted[lower]))
+}
+
+func bucketDurations(durations []time.Duration) []DurationBucket {
+	buckets := make([]DurationBucket, len(durationBuckets))
+	for i, bucket := range durationBuckets {
+		buckets[i].Label = bucket.label
+	}
+
+	for _, d := range durations {
+		for i, bucket := range durationBuckets {
+			if bucket.limit == 0 || d < bucket.limit {
+				buckets[i].Count++
+				break
+			}
+		}
+	}
+
+	return buckets
+}
+
+func correlation(xs, ys []float64) float64 {
+	n := len(xs)
+	if n < 2 || n != len(ys) {
+		return 0
+	}
+
+	var sumX, sumY float64
+	for i := 0; i < n; i++ {
+		sumX += xs[i]
+		sumY += ys[i]
+	}
+	meanX := sumX / float64(n)
+	meanY := sumY / float64(n)
+
+	var cov, varX, varY float64
+	for i := 0; i < n; i++ {
+		dx := xs[i] - meanX
+		dy := ys[i] - meanY
+		cov += dx * dy
+		varX += dx * dx
+		varY += dy * dy
+	}
+
+	if varX == 0 || varY == 0 {
+		return 0
+	}
+	return cov / math.Sqrt(varX*varY)
+}
@@ -0,0 +1,137 @@
+package github
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestSummarizeDurations(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []time.Duration
+		want  DurationStats
+	}{
+		{
+			name:  "empty",
+			input: nil,
+			want:  DurationStats{},
+		},
+		{
+			name:  "single",
+			input: []time.Duration{3 * time.Hour},
+			want: DurationStats{
+				Count: 1, Mean: 3 * time.Hour, Median: 3 * time.Hour,
+				P75: 3 * time.Hour, P90: 3 * time.Hour, Min: 3 * time.Hour, Max: 3 * time.Hour,
+			},
+		},
+		{
+			name:  "unsorted with interpolation",
+			input: []time.Duration{4 * time.Hour, time.Hour, 3 * time.Hour, 2 * time.Hour},
+			want: DurationStats{
+				Count:  4,
+				Mean:   150 * time.Minute,
+				Median: 150 * time.Minute,
+				P75:    195 * time.Minute,
+				P90:    222 * time.Minute,
+				Min:    time.Hour,
+				Max:    4 * time.Hour,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := summarizeDurations(tt.input); got != tt.want {
+				t.Errorf("summarizeDurations() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSummarizeDurationsDoesNotReorderInput(t *testing.T) {
+	input := []time.Duration{3, 1, 2}
+	summarizeDurations(input)
+	if input[0] != 3 || input[1] != 1 || input[2] != 2 {
+		t.Errorf("input was modified: %v", input)
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	tests := []struct {
+		name   string
+		sorted []time.Duration
+		p      float64
+		want   time.Duration
+	}{
+		{"empty", nil, 50, 0},
+		{"single", []time.Duration{5}, 90, 5},
+		{"minimum", []time.Duration{10, 20, 30}, 0, 10},
+		{"maximum", []time.Duration{10, 20, 30}, 100, 30},
+		{"exact rank", []time.Duration{10, 20, 30}, 50, 20},
+		{"interpolated", []time.Duration{10, 20}, 50, 15},
+		{"interpolated quarter", []time.Duration{0, 100, 200, 300, 400}, 90, 360},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := percentile(tt.sorted, tt.p); got != tt.want {
+				t.Errorf("percentile(%v, %v) = %v, want %v", tt.sorted, tt.p, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBucketDurations(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []time.Duration
+		want  []int
+	}{
+		{"empty", nil, []int{0, 0, 0, 0, 0}},
+		{"below first limit", []time.Duration{59 * time.Minute}, []int{1, 0, 0, 0, 0}},
+		{"limit is exclusive", []time.Duration{time.Hour, 24 * time.Hour}, []int{0, 1, 1, 0, 0}},
+		{
+			name:  "every bucket",
+			input: []time.Duration{time.Minute, 2 * time.Hour, 3 * 24 * time.Hour, 10 * 24 * time.Hour, 90 * 24 * time.Hour},
+			want:  []int{1, 1, 1, 1, 1},
+		},
+	}
+
+	labels := []string{"<1h", "<1d", "<1w", "<1m", "longer"}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := bucketDurations(tt.input)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d buckets, want %d", len(got), len(tt.want))
+			}
+			for i, bucket := range got {
+				if bucket.Label != labels[i] || bucket.Count != tt.want[i] {
+					t.Errorf("bucket %d = %+v, want {%s %d}", i, bucket, labels[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestCorrelation(t *testing.T) {
+	tests := []struct {
+		name   string
+		xs, ys []float64
+		want   float64
+	}{
+		{"too few points", []float64{1}, []float64{1}, 0},
+		{"length mismatch", []float64{1, 2}, []float64{1}, 0},
+		{"constant series", []float64{1, 1, 1}, []float64{1, 2, 3}, 0},
+		{"perfect positive", []float64{1, 2, 3}, []float64{2, 4, 6}, 1},
+		{"perfect negative", []float64{1, 2, 3}, []float64{3, 2, 1}, -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := correlation(tt.xs, tt.ys); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("correlation() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,230 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/v81/github"
+)
+
+func (c *Client) GetOrgMembers(org string) ([]string, error) {
+	var members []string
+	opts := &github.ListMembersOptions{
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	for {
+		users, resp, err := c.client.Organizations.ListMembers(c.ctx, org, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list members for org %s: %w", org, err)
+		}
+
+		for _, user := range users {
+			members = append(members, user.GetLogin())
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return members, nil
+}
+
+func (c *Client) GetTeamMembers(org, team string) ([]string, error) {
+	var members []string
+	opts := &github.TeamListTeamMembersOptions{
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	for {
+		users, resp, err := c.client.Teams.ListTeamMembersBySlug(c.ctx, org, team, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list members for team %s/%s: %w", org, team, err)
+		}
+
+		for _, user := range users {
+			members = append(members, user.GetLogin())
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return members, nil
+}
+
+// CalculateMember sums a member's contribution summaries over the period.
+func (s *StatsCalculator) CalculateMember(ctx context.Context, username string, since time.Time) (*MemberStats, error) {
+	member := &MemberStats{Login: username}
+
+	for _, window := range yearWindows(since.UTC(), time.Now().UTC()) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		summary, err := s.client.GetContributionSummary(username, window.From, window.To)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get contributions for %s: %w", username, err)
+		}
+
+		member.TotalContributions += summary.TotalContributions
+		member.Commits += summary.Commits
+		member.PullRequests += summary.PullRequests
+		member.Reviews += summary.Reviews
+		member.Issues += summary.Issues
+	}
+
+	return member, nil
+}
+
+// calculateOrgMember runs the full calculator for a member and adds their
+// contribution and merged PR totals for the period.
+func (s *StatsCalculator) calculateOrgMember(ctx context.Context, username string, since time.Time) (*MemberStats, error) {
+	stats, err := s.Calculate(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	member, err := s.CalculateMember(ctx, username, since)
+	if err != nil {
+		return nil, err
+	}
+	member.Stats = stats
+
+	merged, err := s.client.CountIssues(fmt.Sprintf("author:%s is:pr is:merged merged:>=%s", username, since.Format("2006-01-02")))
+	if err != nil {
+		return nil, fmt.Errorf("failed to count merged PRs for %s: %w", username, err)
+	}
+	member.PullRequestsMerged = merged
+
+	return member, nil
+}
+
+func (s *StatsCalculator) CalculateOrg(ctx context.Context, org, team string, since time.Time) (*OrgReport, error) {
+	var members []string
+	var err error
+	if team != "" {
+		members, err = s.client.GetTeamMembers(org, team)
+	} else {
+		members, err = s.client.GetOrgMembers(org)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	report := &OrgReport{
+		Org:             org,
+		Team:            team,
+		Since:           since,
+		Until:           time.Now().UTC(),
+		Members:         len(members),
+		Languages:       make([]LanguageCount, 0),
+		MemberStats:     make([]MemberStats, 0),
+		InactiveMembers: make([]string, 0),
+	}
+
+	// Every member shares one worker pool and one token, so warn up front
+	// when the remaining GraphQL budget cannot cover the period summaries,
+	// before the full per-member calculations add to it.
+	calls := len(members) * len(yearWindows(since.UTC(), report.Until))
+	if limits, err := s.client.CheckRateLimit(); err == nil && limits.GraphQL != nil && limits.GraphQL.Remaining < calls {
+		warnf("%d GraphQL requests needed but only %d remaining; some members may fail", calls, limits.GraphQL.Remaining)
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	sem := make(chan struct{}, max(1, s.client.maxWorkers/calculateFanOut))
+
+	for _, login := range members {
+		wg.Add(1)
+		go func(login string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			member, err := s.calculateOrgMember(ctx, login, since)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				report.FailedMembers = append(report.FailedMembers, login)
+				return
+			}
+			report.MemberStats = append(report.MemberStats, *member)
+		}(login)
+	}
+
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	repoLanguages, langErr := s.orgLanguages(org)
+	if langErr != nil {
+		warnf("failed to get complete language data: %v", langErr)
+	}
+	report.Languages = languageBreakdown(aggregateLanguages(repoLanguages, s.opts.Languages))
+
+	summarizeOrg(report)
+
+	return report, nil
+}
+
+func (s *StatsCalculator) orgLanguages(org string) (map[string]map[string]int64, error) {
+	repos, err := s.client.listReposByOrg(org)
+	if err != nil {
+		return nil, err
+	}
+	repos, _ = s.opts.Filter.Apply(repos)
+	return s.client.GetLanguages(repos, s.opts.Languages)
+}
+
+func summarizeOrg(report *OrgReport) {
+	sort.Slice(report.MemberStats, func(i, j int) bool {
+		if report.MemberStats[i].TotalContributions != report.MemberStats[j].TotalContributions {
+			return report.MemberStats[i].TotalContributions > report.MemberStats[j].TotalContributions
+		}
+		return report.MemberStats[i].Login < report.MemberStats[j].Login
+	})
+	sort.Strings(report.FailedMembers)
+
+	contributions := make(map[string]int)
+	reviews := make(map[string]int)
+
+	for _, member := range report.MemberStats {
+		report.TotalContributions += member.TotalContributions
+		report.Commits += member.Commits
+		report.PullRequests += member.PullRequests
+		report.PullRequestsMerged += member.PullRequestsMerged
+		report.Reviews += member.Reviews
+		report.Issues += member.Issues
+
+		if member.TotalContributions == 0 {
+			report.InactiveMembers = append(report.InactiveMembers, member.Login)
+			continue
+		}
+		report.ActiveMembers++
+		contributions[member.Login] = member.TotalContributions
+		if member.Reviews > 0 {
+			reviews[member.Login] = member.Reviews
+		}
+	}
+	sort.Strings(report.InactiveMembers)
+
+	weeks := report.Until.Sub(report.Since).Hours() / (24 * 7)
+	if weeks < 1 {
+		weeks = 1
+	}
+	report.PRsPerWeek = float64(report.PullRequestsMerged) / weeks
+	report.ReviewsPerWeek = float64(report.Reviews) / weeks
+
+	report.TopContributors = getTopUsers(contributions, 10)
+	report.TopReviewers = getTopUsers(reviews, 10)
+}
@@ -0,0 +1,348 @@
+package github
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var prSizes = []struct {
+	label string
+	limit int
+}{
+	{"XS", 10},
+	{"S", 30},
+	{"M", 100},
+	{"L", 500},
+	{"XL", 0},
+}
+
+var coAuthorTrailer = regexp.MustCompile(`(?im)^co-authored-by:[ \t]*(.*?)[ \t]*<([^>]*)>[ \t]*$`)
+
+var noreplyEmail = regexp.MustCompile(`(?i)^(?:\d+\+)?([a-z0-9-]+)@users\.noreply\.github\.com$`)
+
+type pullRequestSearchResponse struct {
+	Search struct {
+		IssueCount int               `json:"issueCount"`
+		Nodes      []pullRequestNode `json:"nodes"`
+		PageInfo   pageInfo          `json:"pageInfo"`
+	} `json:"search"`
+}
+
+type pullRequestNode struct {
+	Number       int        `json:"number"`
+	Title        string     `json:"title"`
+	URL          string     `json:"url"`
+	State        string     `json:"state"`
+	CreatedAt    time.Time  `json:"createdAt"`
+	MergedAt     *time.Time `json:"mergedAt"`
+	ClosedAt     *time.Time `json:"closedAt"`
+	Additions    int        `json:"additions"`
+	Deletions    int        `json:"deletions"`
+	ChangedFiles int        `json:"changedFiles"`
+	Author       *struct {
+		Login string `json:"login"`
+	} `json:"author"`
+	Repository struct {
+		NameWithOwner  string `json:"nameWithOwner"`
+		StargazerCount int    `json:"stargazerCount"`
+	} `json:"repository"`
+	Commits struct {
+		TotalCount int `json:"totalCount"`
+		Nodes      []struct {
+			Commit struct {
+				Message string `json:"message"`
+				Authors struct {
+					Nodes []struct {
+						User *struct {
+							Login string `json:"login"`
+						} `json:"user"`
+					} `json:"nodes"`
+				} `json:"authors"`
+			} `json:"commit"`
+		} `json:"nodes"`
+	} `json:"commits"`
+	Reviews struct {
+		Nodes []struct {
+			Author *struct {
+				Login string `json:"login"`
+			} `json:"author"`
+			State       string     `json:"state"`
+			SubmittedAt *time.Time `json:"submittedAt"`
+		} `json:"nodes"`
+	} `json:"reviews"`
+}
+
+func (c *Client) GetUserPullRequests(username string) (*PullRequestStats, error) {
+	details, err := c.GetPullRequestDetails(username)
+	if err != nil {
+		return nil, err
+	}
+	return summarizePullRequests(details), nil
+}
+
+func (c *Client) GetPullRequestDetails(username string) ([]PullRequestDetail, error) {
+	if c.hasState() {
+		return c.incrementalPullRequests(username)
+	}
+	return c.SearchPullRequests(fmt.Sprintf("author:%s is:pr sort:created-desc", username))
+}
+
+func (c *Client) SearchPullRequests(search string) ([]PullRequestDetail, error) {
+	query := `
+		query($query: String!, $after: String) {
+			search(query: $query, type: ISSUE, first: 50, after: $after) {
+				issueCount
+				nodes {
+					... on PullRequest {
+						number
+						title
+						url
+						state
+						createdAt
+						mergedAt
+						closedAt
+						additions
+						deletions
+						changedFiles
+						author {
+							login
+						}
+						repository {
+							nameWithOwner
+							stargazerCount
+						}
+						commits(first: 50) {
+							totalCount
+							nodes {
+								commit {
+									message
+									authors(first: 10) {
+										nodes {
+											user {
+												login
+											}
+										}
+									}
+								}
+							}
+						}
+						reviews(first: 100) {
+							nodes {
+								author {
+									login
+								}
+								state
+								submittedAt
+							}
+						}
+					}
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	`
+
+	var details []PullRequestDetail
+	var cursor *string
+
+	for {
+		variables := map[string]interface{}{
+			"query": search,
+		}
+		if cursor != nil {
+			variables["after"] = *cursor
+		}
+
+		var result pullRequestSearchResponse
+		if err := c.executeGraphQL(query, variables, &result); err != nil {
+			return nil, fmt.Errorf("failed to search PRs: %w", err)
+		}
+
+		for _, node := range result.Search.Nodes {
+			details = append(details, node.toDetail())
+		}
+
+		if !result.Search.PageInfo.HasNextPage {
+			break
+		}
+		cursor = &result.Search.PageInfo.EndCursor
+	}
+
+	return details, nil
+}
+
+func (n pullRequestNode) toDetail() PullRequestDetail {
+	detail := PullRequestDetail{
+		Repository:   n.Repository.NameWithOwner,
+		RepoStars:    n.Repository.StargazerCount,
+		Number:       n.Number,
+		Title:        n.Title,
+		URL:          n.URL,
+		State:        n.State,
+		CreatedAt:    n.CreatedAt,
+		Additions:    n.Additions,
+		Deletions:    n.Deletions,
+		ChangedFiles: n.ChangedFiles,
+		Commits:      n.Commits.TotalCount,
+		Size:         classifyPRSize(n.Additions + n.Deletions),
+	}
+	if n.MergedAt != nil {
+		detail.MergedAt = *n.MergedAt
+	}
+	if n.ClosedAt != nil {
+		detail.ClosedAt = *n.ClosedAt
+	}
+
+	author := ""
+	if n.Author != nil {
+		author = n.Author.Login
+	}
+
+	// GitHub resolves Co-authored-by trailers to accounts by verified email;
+	// noreply trailers cover co-authors it could not match.
+	coAuthors := make(map[string]bool)
+	for _, commit := range n.Commits.Nodes {
+		logins := parseCoAuthors(commit.Commit.Message)
+		for _, commitAuthor := range commit.Commit.Authors.Nodes {
+			if commitAuthor.User != nil {
+				logins = append(logins, commitAuthor.User.Login)
+			}
+		}
+		for _, coAuthor := range logins {
+			if strings.EqualFold(coAuthor, author) || coAuthors[strings.ToLower(coAuthor)] {
+				continue
+			}
+			coAuthors[strings.ToLower(coAuthor)] = true
+			detail.CoAuthors = append(detail.CoAuthors, coAuthor)
+		}
+	}
+
+	reviewers := make(map[string]bool)
+	changesRequested := 0
+	for _, review := range n.Reviews.Nodes {
+		if review.Author == nil || review.Author.Login == author || review.SubmittedAt == nil {
+			continue
+		}
+		if !reviewers[review.Author.Login] {
+			reviewers[review.Author.Login] = true
+			detail.Reviewers = append(detail.Reviewers, review.Author.Login)
+		}
+		if detail.FirstReviewAt.IsZero() || review.SubmittedAt.Before(detail.FirstReviewAt) {
+			detail.FirstReviewAt = *review.SubmittedAt
+		}
+		if review.State == "CHANGES_REQUESTED" {
+			changesRequested++
+		}
+	}
+
+	// Every "changes requested" review forces another round; the final
+	// review that lets the PR through counts as the last one.
+	if len(reviewers) > 0 {
+		detail.ReviewRounds = changesRequested + 1
+	}
+
+	return detail
+}
+
+func classifyPRSize(linesChanged int) string {
+	for _, size := range prSizes {
+		if size.limit == 0 || linesChanged < size.limit {
+			return size.label
+		}
+	}
+	return prSizes[len(prSizes)-1].label
+}
+
+func summarizePullRequests(details []PullRequestDetail) *PullRequestStats {
+	stats := &PullRequestStats{
+		TopRepos: make([]RepoCount, 0),
+	}
+
+	repoCount := make(map[string]int)
+	sizeMergeTimes := make(map[string][]time.Duration)
+	sizeCount := make(map[string]int)
+	var mergeTimes, firstReviewTimes []time.Duration
+	var mergedLines, mergedHours []float64
+	var additions, deletions, changedFiles, commits, rounds, reviewers, reviewed int
+
+	for _, pr := range details {
+		stats.Total++
+		repoCount[pr.Repository]++
+		sizeCount[pr.Size]++
+
+		additions += pr.Additions
+		deletions += pr.Deletions
+		changedFiles += pr.ChangedFiles
+		commits += pr.Commits
+
+		switch pr.State {
+		case "OPEN":
+			stats.Open++
+		case "CLOSED":
+			stats.Closed++
+		case "MERGED":
+			stats.Merged++
+			mergeTime := pr.MergedAt.Sub(pr.CreatedAt)
+			mergeTimes = append(mergeTimes, mergeTime)
+			sizeMergeTimes[pr.Size] = append(sizeMergeTimes[pr.Size], mergeTime)
+			mergedLines = append(mergedLines, float64(pr.Additions+pr.Deletions))
+			mergedHours = append(mergedHours, mergeTime.Hours())
+		}
+
+		if !pr.FirstReviewAt.IsZero() {
+			reviewed++
+			firstReviewTimes = append(firstReviewTimes, pr.FirstReviewAt.Sub(pr.CreatedAt))
+			rounds += pr.ReviewRounds
+			reviewers += len(pr.Reviewers)
+		}
+	}
+
+	stats.MergeTime = summarizeDurations(mergeTimes)
+	stats.MergeTimeHistogram = bucketDurations(mergeTimes)
+	stats.AvgMergeTime = stats.MergeTime.Mean
+	stats.TopRepos = getTopRepos(repoCount, 5)
+
+	if stats.Total > 0 {
+		total := float64(stats.Total)
+		stats.AvgAdditions = float64(additions) / total
+		stats.AvgDeletions = float64(deletions) / total
+		stats.AvgChangedFiles = float64(changedFiles) / total
+		stats.AvgCommits = float64(commits) / total
+	}
+
+	for _, size := range prSizes {
+		stats.SizeBreakdown = append(stats.SizeBreakdown, PRSizeStats{
+			Size:            size.label,
+			Count:           sizeCount[size.label],
+			Merged:          len(sizeMergeTimes[size.label]),
+			MedianMergeTime: summarizeDurations(sizeMergeTimes[size.label]).Median,
+		})
+	}
+	stats.SizeMergeCorrelation = correlation(mergedLines, mergedHours)
+
+	stats.TimeToFirstReview = summarizeDurations(firstReviewTimes)
+	if reviewed > 0 {
+		stats.AvgReviewRounds = float64(rounds) / float64(reviewed)
+		stats.AvgReviewers = float64(reviewers) / float64(reviewed)
+	}
+
+	return stats
+}
+
+// parseCoAuthors returns the logins named in Co-authored-by trailers. Only
+// GitHub noreply addresses identify a login, so other trailers are skipped
+// rather than recorded under a display name.
+func parseCoAuthors(message string) []string {
+	var coAuthors []string
+	for _, match := range coAuthorTrailer.FindAllStringSubmatch(message, -1) {
+		if login := noreplyEmail.FindStringSubmatch(match[2]); login != nil {
+			coAuthors = append(coAuthors, login[1])
+		}
+	}
+	return coAuthors
+}
@@ -0,0 +1,49 @@
+package github
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestParseCoAuthors(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		want    []string
+	}{
+		{"noreply address", "Fix bug\n\nCo-authored-by: Jane Doe <jane@users.noreply.github.com>", []string{"jane"}},
+		{"numbered noreply address", "Fix bug\n\nCo-authored-by: Jane <123+jane-d@users.noreply.github.com>", []string{"jane-d"}},
+		{"personal address skipped", "Fix bug\n\nCo-authored-by: Jane Doe <jane@example.com>", nil},
+		{"no trailers", "Fix bug", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseCoAuthors(tt.message); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseCoAuthors() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPullRequestCoAuthorsUseResolvedLogins(t *testing.T) {
+	var node pullRequestNode
+	data := `{
+		"author": {"login": "me"},
+		"commits": {"nodes": [
+			{"commit": {
+				"message": "Pair on it\n\nCo-authored-by: Jane Doe <jane@example.com>\nCo-authored-by: Bob <bob@users.noreply.github.com>",
+				"authors": {"nodes": [{"user": {"login": "me"}}, {"user": {"login": "jane"}}, {"user": null}]}
+			}}
+		]}
+	}`
+	if err := json.Unmarshal([]byte(data), &node); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"bob", "jane"}
+	if got := node.toDetail().CoAuthors; !reflect.DeepEqual(got, want) {
+		t.Errorf("CoAuthors = %q, want %q", got, want)
+	}
+}
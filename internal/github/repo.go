@@ -0,0 +1,418 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/v81/github"
+)
+
+func (c *Client) GetRepository(owner, name string) (*github.Repository, error) {
+	repo, _, err := c.client.Repositories.Get(c.ctx, owner, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repository %s/%s: %w", owner, name, err)
+	}
+	return repo, nil
+}
+
+// GetWatcherCounts fetches each repository individually, since list
+// endpoints leave subscribers_count unset and report stars as watchers.
+func (c *Client) GetWatcherCounts(fullNames []string) (map[string]int, error) {
+	counts := make(map[string]int)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	sem := make(chan struct{}, c.maxWorkers)
+	errChan := make(chan error, len(fullNames))
+
+	for _, fullName := range fullNames {
+		owner, name, ok := strings.Cut(fullName, "/")
+		if !ok {
+			continue
+		}
+		wg.Add(1)
+		go func(fullName, owner, name string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			repo, err := c.GetRepository(owner, name)
+			if err != nil {
+				errChan <- err
+				return
+			}
+
+			mu.Lock()
+			counts[fullName] = repo.GetSubscribersCount()
+			mu.Unlock()
+		}(fullName, owner, name)
+	}
+
+	wg.Wait()
+	close(errChan)
+
+	var firstErr error
+	for err := range errChan {
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return counts, firstErr
+}
+
+// GetWindowCommits returns the dates of commits since the given time and
+// how many of them each linked GitHub account authored.
+func (c *Client) GetWindowCommits(owner, name string, since time.Time) ([]time.Time, map[string]int, error) {
+	var dates []time.Time
+	authors := make(map[string]int)
+	opts := &github.CommitsListOptions{
+		Since:       since,
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	for {
+		commits, resp, err := c.client.Repositories.ListCommits(c.ctx, owner, name, opts)
+		if err != nil {
+			if resp != nil && resp.StatusCode == 409 {
+				return dates, authors, nil
+			}
+			return nil, nil, fmt.Errorf("failed to list commits: %w", err)
+		}
+
+		for _, commit := range commits {
+			if commit.Commit != nil && commit.Commit.Author != nil && commit.Commit.Author.Date != nil {
+				dates = append(dates, commit.Commit.Author.Date.UTC())
+			}
+			if login := commit.GetAuthor().GetLogin(); login != "" {
+				authors[login]++
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return dates, authors, nil
+}
+
+// searchLimit is the most results GitHub search returns for one query.
+const searchLimit = 1000
+
+// searchWindow runs search over base restricted to qualifier:from..to,
+// halving the window until each part fits within searchLimit so busy
+// repositories are not undercounted.
+func searchWindow[T any](c *Client, base, qualifier string, from, to time.Time, search func(string) ([]T, error)) ([]T, error) {
+	query := fmt.Sprintf("%s %s:%s..%s", base, qualifier, from.Format(time.RFC3339), to.Format(time.RFC3339))
+	total, err := c.CountIssues(query)
+	if err != nil {
+		return nil, err
+	}
+
+	if total > searchLimit {
+		if to.Sub(from) >= 2*time.Minute {
+			mid := from.Add(to.Sub(from) / 2).Truncate(time.Second)
+			first, err := searchWindow(c, base, qualifier, from, mid, search)
+			if err != nil {
+				return nil, err
+			}
+			second, err := searchWindow(c, base, qualifier, mid.Add(time.Second), to, search)
+			if err != nil {
+				return nil, err
+			}
+			return append(first, second...), nil
+		}
+		warnf("%q matches %d results but search returns at most %d; counts are understated", query, total, searchLimit)
+	}
+
+	return search(query)
+}
+
+func (c *Client) GetReleases(owner, name string) ([]*github.RepositoryRelease, error) {
+	var releases []*github.RepositoryRelease
+	opts := &github.ListOptions{PerPage: 100}
+
+	for {
+		page, resp, err := c.client.Repositories.ListReleases(c.ctx, owner, name, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list releases: %w", err)
+		}
+		releases = append(releases, page...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return releases, nil
+}
+
+func (s *StatsCalculator) CalculateRepo(ctx context.Context, owner, name string, since time.Time) (*RepoReport, error) {
+	repo, err := s.client.GetRepository(owner, name)
+	if err != nil {
+		return nil, err
+	}
+
+	fullName := repo.GetFullName()
+	report := &RepoReport{
+		FullName:    fullName,
+		Description: repo.GetDescription(),
+		Stars:       repo.GetStargazersCount(),
+		Forks:       repo.GetForksCount(),
+		Watchers:    repo.GetSubscribersCount(),
+		OpenIssues:  repo.GetOpenIssuesCount(),
+		Since:       since,
+		Until:       time.Now().UTC(),
+	}
+
+	var (
+		wg            sync.WaitGroup
+		commitDates   []time.Time
+		authors       map[string]int
+		prs           []PullRequestDetail
+		merged        []PullRequestDetail
+		issues        []IssueDetail
+		issuesClosed  int
+		releases      []*github.RepositoryRelease
+		languages     map[string]int64
+		commitsErr    error
+		prErr         error
+		mergedErr     error
+		issueErr      error
+		issueCountErr error
+		releaseErr    error
+		langErr       error
+	)
+
+	day := since.Format("2006-01-02")
+	wg.Add(7)
+
+	go func() {
+		defer wg.Done()
+		commitDates, authors, commitsErr = s.client.GetWindowCommits(owner, name, since)
+	}()
+
+	go func() {
+		defer wg.Done()
+		prs, prErr = searchWindow(s.client, fmt.Sprintf("repo:%s is:pr", fullName), "created", since, report.Until, s.client.SearchPullRequests)
+	}()
+
+	go func() {
+		defer wg.Done()
+		merged, mergedErr = searchWindow(s.client, fmt.Sprintf("repo:%s is:pr is:merged", fullName), "merged", since, report.Until, s.client.SearchPullRequests)
+	}()
+
+	go func() {
+		defer wg.Done()
+		issues, issueErr = searchWindow(s.client, fmt.Sprintf("repo:%s is:issue", fullName), "created", since, report.Until, s.client.SearchIssues)
+	}()
+
+	go func() {
+		defer wg.Done()
+		issuesClosed, issueCountErr = s.client.CountIssues(fmt.Sprintf("repo:%s is:issue closed:>=%s", fullName, day))
+	}()
+
+	go func() {
+		defer wg.Done()
+		releases, releaseErr = s.client.GetReleases(owner, name)
+	}()
+
+	go func() {
+		defer wg.Done()
+		var repoLanguages map[string]map[string]int64
+		repoLanguages, langErr = s.client.GetRepoLanguages([]*github.Repository{repo})
+		languages = repoLanguages[fullName]
+	}()
+
+	wg.Wait()
+
+	weeks := report.Until.Sub(since).Hours() / (24 * 7)
+	if weeks < 1 {
+		weeks = 1
+	}
+
+	if commitsErr != nil {
+		warnf("failed to get commits: %v", commitsErr)
+	} else {
+		report.Commits = len(commitDates)
+		report.WeeklyCommits = weeklyCounts(commitDates, since, report.Until)
+		report.TopContributors = getTopUsers(authors, 10)
+	}
+
+	if prErr != nil {
+		warnf("failed to get pull requests: %v", prErr)
+	} else {
+		summarizeRepoPullRequests(report, prs)
+	}
+
+	if mergedErr != nil {
+		warnf("failed to get merged pull requests: %v", mergedErr)
+	} else {
+		summarizeRepoMerges(report, merged, weeks)
+	}
+
+	if issueErr != nil {
+		warnf("failed to get issues: %v", issueErr)
+	} else {
+		summarizeRepoIssues(report, issues, weeks)
+	}
+
+	if issueCountErr != nil {
+		warnf("failed to count closed issues: %v", issueCountErr)
+	} else {
+		report.IssuesClosed = issuesClosed
+		report.IssuesClosedPerWeek = float64(issuesClosed) / weeks
+	}
+
+	if releaseErr != nil {
+		warnf("failed to get releases: %v", releaseErr)
+	} else {
+		summarizeReleases(report, releases)
+	}
+
+	if langErr != nil {
+		warnf("failed to get languages: %v", langErr)
+	} else {
+		report.Languages = languageBreakdown(languages)
+	}
+
+	return report, nil
+}
+
+// summarizeRepoPullRequests covers PRs opened in the window.
+func summarizeRepoPullRequests(report *RepoReport, prs []PullRequestDetail) {
+	reviewerCount := make(map[string]int)
+	var firstReviewTimes []time.Duration
+
+	for _, pr := range prs {
+		report.PRsOpened++
+		if pr.State == "CLOSED" {
+			report.PRsClosed++
+		}
+		if !pr.FirstReviewAt.IsZero() {
+			firstReviewTimes = append(firstReviewTimes, pr.FirstReviewAt.Sub(pr.CreatedAt))
+		}
+		for _, reviewer := range pr.Reviewers {
+			reviewerCount[reviewer]++
+		}
+	}
+
+	report.PRFirstResponse = summarizeDurations(firstReviewTimes)
+	report.TopReviewers = getTopUsers(reviewerCount, 10)
+}
+
+// summarizeRepoMerges covers PRs merged in the window, whenever they were
+// opened.
+func summarizeRepoMerges(report *RepoReport, merged []PullRequestDetail, weeks float64) {
+	var mergeTimes []time.Duration
+	for _, pr := range merged {
+		report.PRsMerged++
+		mergeTimes = append(mergeTimes, pr.MergedAt.Sub(pr.CreatedAt))
+	}
+
+	report.PRsMergedPerWeek = float64(report.PRsMerged) / weeks
+	report.MergeTime = summarizeDurations(mergeTimes)
+}
+
+func summarizeRepoIssues(report *RepoReport, issues []IssueDetail, weeks float64) {
+	var closeTimes, responseTimes []time.Duration
+
+	for _, issue := range issues {
+		report.IssuesOpened++
+		if issue.State == "CLOSED" && !issue.ClosedAt.IsZero() {
+			closeTimes = append(closeTimes, issue.ClosedAt.Sub(issue.CreatedAt))
+		}
+		if !issue.FirstResponseAt.IsZero() {
+			responseTimes = append(responseTimes, issue.FirstResponseAt.Sub(issue.CreatedAt))
+		}
+	}
+
+	report.IssuesOpenedPerWeek = float64(report.IssuesOpened) / weeks
+	report.IssueCloseTime = summarizeDurations(closeTimes)
+	report.IssueFirstResponse = summarizeDurations(responseTimes)
+}
+
+func summarizeReleases(report *RepoReport, releases []*github.RepositoryRelease) {
+	var dates []time.Time
+	for _, release := range releases {
+		if release.GetDraft() || release.PublishedAt == nil {
+			continue
+		}
+		published := release.PublishedAt.UTC()
+		dates = append(dates, published)
+
+		if published.After(report.LatestReleaseAt) {
+			report.LatestRelease = release.GetTagName()
+			report.LatestReleaseAt = published
+		}
+		if !published.Before(report.Since) {
+			report.Releases++
+		}
+	}
+
+	report.TotalReleases = len(dates)
+
+	sort.Slice(dates, func(i, j int) bool {
+		return dates[i].Before(dates[j])
+	})
+
+	var intervals []time.Duration
+	for i := 1; i < len(dates); i++ {
+		intervals = append(intervals, dates[i].Sub(dates[i-1]))
+	}
+	report.ReleaseInterval = summarizeDurations(intervals)
+}
+
+func languageBreakdown(languages map[string]int64) []LanguageCount {
+	var total int64
+	for _, bytes := range languages {
+		total += bytes
+	}
+
+	var breakdown []LanguageCount
+	for lang, bytes := range languages {
+		entry := LanguageCount{Name: lang, Count: int(bytes)}
+		if total > 0 {
+			entry.Percentage = float64(bytes) / float64(total) * 100.0
+		}
+		breakdown = append(breakdown, entry)
+	}
+
+	sort.Slice(breakdown, func(i, j int) bool {
+		if breakdown[i].Count != breakdown[j].Count {
+			return breakdown[i].Count > breakdown[j].Count
+		}
+		return breakdown[i].Name < breakdown[j].Name
+	})
+
+	return breakdown
+}
+
+func weeklyCounts(dates []time.Time, since, until time.Time) []WeekCount {
+	counts := make(map[string]int)
+	for _, date := range dates {
+		counts[weekStart(date).Format("2006-01-02")]++
+	}
+
+	var weeks []WeekCount
+	for week := weekStart(since); !week.After(until); week = week.AddDate(0, 0, 7) {
+		weeks = append(weeks, WeekCount{
+			Week:  week,
+			Count: counts[week.Format("2006-01-02")],
+		})
+	}
+	return weeks
+}
+
+func weekStart(t time.Time) time.Time {
+	t = t.UTC()
+	offset := (int(t.Weekday()) + 6) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, time.UTC)
+}
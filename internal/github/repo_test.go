@@ -0,0 +1,68 @@
+package github
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSearchWindowSplitsLargeResults(t *testing.T) {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 8)
+
+	// The full window matches more than the search limit; each half fits.
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		total := 600
+		if strings.Contains(r.URL.Query().Get("q"), from.Format(time.RFC3339)+".."+to.Format(time.RFC3339)) {
+			total = 1200
+		}
+		_, _ = fmt.Fprintf(w, `{"total_count":%d,"items":[]}`, total)
+	})
+
+	var mu sync.Mutex
+	var queries []string
+	results, err := searchWindow(client, "repo:me/repo is:pr", "created", from, to, func(query string) ([]string, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		queries = append(queries, query)
+		return []string{query}, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(results) != 2 || len(queries) != 2 {
+		t.Fatalf("ran %d searches (%q), want 2 halves", len(queries), queries)
+	}
+	mid := from.AddDate(0, 0, 4)
+	want := []string{
+		fmt.Sprintf("repo:me/repo is:pr created:%s..%s", from.Format(time.RFC3339), mid.Format(time.RFC3339)),
+		fmt.Sprintf("repo:me/repo is:pr created:%s..%s", mid.Add(time.Second).Format(time.RFC3339), to.Format(time.RFC3339)),
+	}
+	for i := range want {
+		if queries[i] != want[i] {
+			t.Errorf("query %d = %q, want %q", i, queries[i], want[i])
+		}
+	}
+}
+
+func TestSummarizeRepoMergesCountsMergedInWindow(t *testing.T) {
+	created := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	merged := []PullRequestDetail{
+		{State: "MERGED", CreatedAt: created, MergedAt: created.Add(48 * time.Hour)},
+		{State: "MERGED", CreatedAt: created, MergedAt: created.Add(24 * time.Hour)},
+	}
+
+	report := &RepoReport{}
+	summarizeRepoMerges(report, merged, 2)
+
+	if report.PRsMerged != 2 || report.PRsMergedPerWeek != 1 {
+		t.Errorf("merged = %d (%.1f/week), want 2 (1.0/week)", report.PRsMerged, report.PRsMergedPerWeek)
+	}
+	if report.PRsOpened != 0 {
+		t.Errorf("opened = %d, want 0 for PRs opened before the window", report.PRsOpened)
+	}
+}
@@ -0,0 +1,435 @@
+package github
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/go-github/v81/github"
+)
+
+const (
+	AffiliationOwner              = "owner"
+	AffiliationCollaborator       = "collaborator"
+	AffiliationOrganizationMember = "organization_member"
+)
+
+type RepoListOptions struct {
+	Affiliations []string
+	Orgs         []string
+}
+
+func (c *Client) GetRepositories(username string, opts RepoListOptions) ([]*github.Repository, error) {
+	affiliations := opts.Affiliations
+	if len(affiliations) == 0 {
+		affiliations = []string{AffiliationOwner}
+	}
+	if len(opts.Orgs) > 0 && !slices.Contains(affiliations, AffiliationOrganizationMember) {
+		affiliations = append(affiliations, AffiliationOrganizationMember)
+	}
+
+	// Organization repositories go through listOrgMemberRepos on every
+	// path, so the same flags select the same repositories whoever owns
+	// the token.
+	var personal []string
+	for _, affiliation := range affiliations {
+		if affiliation != AffiliationOrganizationMember {
+			personal = append(personal, affiliation)
+		}
+	}
+
+	var repos []*github.Repository
+	var err error
+
+	if len(personal) > 0 {
+		if login, authErr := c.GetAuthenticatedUser(); authErr == nil && strings.EqualFold(login, username) {
+			repos, err = c.listAuthenticatedUserRepos(personal)
+		} else {
+			repos, err = c.listUserRepos(username, personal)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(personal) < len(affiliations) {
+		orgRepos, err := c.listOrgMemberRepos(username, opts.Orgs)
+		if err != nil {
+			return nil, err
+		}
+		repos = append(repos, orgRepos...)
+	}
+
+	return filterByOrg(dedupeRepos(repos), opts.Orgs), nil
+}
+
+func (c *Client) listAuthenticatedUserRepos(affiliations []string) ([]*github.Repository, error) {
+	var allRepos []*github.Repository
+	opts := &github.RepositoryListByAuthenticatedUserOptions{
+		Affiliation: strings.Join(affiliations, ","),
+		Sort:        "updated",
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	for {
+		repos, resp, err := c.client.Repositories.ListByAuthenticatedUser(c.ctx, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list repositories: %w", err)
+		}
+
+		allRepos = append(allRepos, repos...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return allRepos, nil
+}
+
+func (c *Client) listUserRepos(username string, affiliations []string) ([]*github.Repository, error) {
+	var allRepos []*github.Repository
+
+	for _, affiliation := range affiliations {
+		switch affiliation {
+		case AffiliationOwner:
+			repos, err := c.listReposByUser(username, "owner")
+			if err != nil {
+				return nil, err
+			}
+			allRepos = append(allRepos, repos...)
+		case AffiliationCollaborator:
+			repos, err := c.listReposByUser(username, "member")
+			if err != nil {
+				return nil, err
+			}
+			allRepos = append(allRepos, repos...)
+		}
+	}
+
+	return allRepos, nil
+}
+
+// listOrgMemberRepos returns the repositories of orgs, or of every
+// organization username belongs to, that username has contributed to.
+// Organizations list every repository they own, so without the filter a
+// member would be credited with the whole organization.
+func (c *Client) listOrgMemberRepos(username string, orgs []string) ([]*github.Repository, error) {
+	orgNames := orgs
+	if len(orgNames) == 0 {
+		var err error
+		orgNames, err = c.GetUserOrgs(username)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(orgNames) == 0 {
+		return nil, nil
+	}
+
+	contributed, err := c.listContributedRepos(username)
+	if err != nil {
+		return nil, err
+	}
+
+	var allRepos []*github.Repository
+	for _, org := range orgNames {
+		repos, err := c.listReposByOrg(org)
+		if err != nil {
+			return nil, err
+		}
+		for _, repo := range repos {
+			if contributed[strings.ToLower(repo.GetFullName())] {
+				allRepos = append(allRepos, repo)
+			}
+		}
+	}
+	return allRepos, nil
+}
+
+type contributedReposResponse struct {
+	User struct {
+		RepositoriesContributedTo struct {
+			Nodes []struct {
+				NameWithOwner string `json:"nameWithOwner"`
+			} `json:"nodes"`
+			PageInfo pageInfo `json:"pageInfo"`
+		} `json:"repositoriesContributedTo"`
+	} `json:"user"`
+}
+
+// listContributedRepos returns the lowercased names of repositories owned by
+// others that the user has contributed to. repositoriesContributedTo only
+// covers roughly the last year of commits, PRs, issues and reviews, so it is
+// combined with the commit contributions of every year since the account
+// was created; older work that never reached a commit is not found.
+func (c *Client) listContributedRepos(username string) (map[string]bool, error) {
+	query := `
+		query($username: String!, $after: String) {
+			user(login: $username) {
+				repositoriesContributedTo(first: 100, after: $after, includeUserRepositories: false,
+					contributionTypes: [COMMIT, PULL_REQUEST, PULL_REQUEST_REVIEW, ISSUE]) {
+					nodes {
+						nameWithOwner
+					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+	`
+
+	contributed := make(map[string]bool)
+	var cursor *string
+
+	for {
+		variables := map[string]interface{}{
+			"username": username,
+		}
+		if cursor != nil {
+			variables["after"] = *cursor
+		}
+
+		var result contributedReposResponse
+		if err := c.executeGraphQL(query, variables, &result); err != nil {
+			return nil, fmt.Errorf("failed to list contributed repositories: %w", err)
+		}
+
+		for _, node := range result.User.RepositoriesContributedTo.Nodes {
+			contributed[strings.ToLower(node.NameWithOwner)] = true
+		}
+
+		if !result.User.RepositoriesContributedTo.PageInfo.HasNextPage {
+			break
+		}
+		cursor = &result.User.RepositoriesContributedTo.PageInfo.EndCursor
+	}
+
+	user, err := c.GetUser(username)
+	if err != nil {
+		return nil, err
+	}
+	commits, _, err := c.GetCommitContributionsByRepo(username, user.GetCreatedAt().Time)
+	if err != nil {
+		return nil, err
+	}
+	for _, repo := range commits {
+		contributed[strings.ToLower(repo.RepoName)] = true
+	}
+
+	return contributed, nil
+}
+
+func (c *Client) listReposByUser(username, repoType string) ([]*github.Repository, error) {
+	var allRepos []*github.Repository
+	opts := &github.RepositoryListByUserOptions{
+		Type:        repoType,
+		Sort:        "updated",
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	for {
+		repos, resp, err := c.client.Repositories.ListByUser(c.ctx, username, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list repositories: %w", err)
+		}
+
+		allRepos = append(allRepos, repos...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return allRepos, nil
+}
+
+func (c *Client) listReposByOrg(org string) ([]*github.Repository, error) {
+	c.cacheMu.Lock()
+	cached, ok := c.orgRepoCache[org]
+	c.cacheMu.Unlock()
+	if ok {
+		return cached, nil
+	}
+
+	var allRepos []*github.Repository
+	opts := &github.RepositoryListByOrgOptions{
+		Sort:        "updated",
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	for {
+		repos, resp, err := c.client.Repositories.ListByOrg(c.ctx, org, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list repositories for org %s: %w", org, err)
+		}
+
+		allRepos = append(allRepos, repos...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	c.cacheMu.Lock()
+	c.orgRepoCache[org] = allRepos
+	c.cacheMu.Unlock()
+
+	return allRepos, nil
+}
+
+// GetUserOrgs lists the organizations username belongs to. For the token
+// owner this includes private memberships; for anyone else only public ones
+// are visible.
+func (c *Client) GetUserOrgs(username string) ([]string, error) {
+	key := strings.ToLower(username)
+	c.cacheMu.Lock()
+	cached, ok := c.userOrgCache[key]
+	c.cacheMu.Unlock()
+	if ok {
+		return cached, nil
+	}
+
+	user := username
+	if login, err := c.GetAuthenticatedUser(); err == nil && strings.EqualFold(login, username) {
+		user = ""
+	}
+
+	orgNames := make([]string, 0)
+	opts := &github.ListOptions{PerPage: 100}
+
+	for {
+		orgs, resp, err := c.client.Organizations.List(c.ctx, user, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list organizations: %w", err)
+		}
+
+		for _, org := range orgs {
+			if org.Login != nil {
+				orgNames = append(orgNames, *org.Login)
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	c.cacheMu.Lock()
+	c.userOrgCache[key] = orgNames
+	c.cacheMu.Unlock()
+
+	return orgNames, nil
+}
+
+func dedupeRepos(repos []*github.Repository) []*github.Repository {
+	seen := make(map[string]bool)
+	var unique []*github.Repository
+	for _, repo := range repos {
+		name := repo.GetFullName()
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		unique = append(unique, repo)
+	}
+	return unique
+}
+
+func filterByOrg(repos []*github.Repository, orgs []string) []*github.Repository {
+	if len(orgs) == 0 {
+		return repos
+	}
+
+	var filtered []*github.Repository
+	for _, repo := range repos {
+		if containsFold(orgs, repo.GetOwner().GetLogin()) {
+			filtered = append(filtered, repo)
+		}
+	}
+	return filtered
+}
+
+// RepoAffiliation classifies repo relative to username. Repositories of an
+// organization only count as organization_member when username belongs to
+// one of memberOrgs; otherwise access came through collaboration.
+func RepoAffiliation(repo *github.Repository, username string, memberOrgs []string) string {
+	owner := repo.GetOwner()
+	switch {
+	case strings.EqualFold(owner.GetLogin(), username):
+		return AffiliationOwner
+	case owner.GetType() == "Organization" && containsFold(memberOrgs, owner.GetLogin()):
+		return AffiliationOrganizationMember
+	default:
+		return AffiliationCollaborator
+	}
+}
+
+type RepoFilter struct {
+	Include       []string
+	Exclude       []string
+	SkipArchived  bool
+	SkipTemplates bool
+	MinStars      int
+	Topics        []string
+	ExcludeTopics []string
+}
+
+func (f RepoFilter) Apply(repos []*github.Repository) ([]*github.Repository, int) {
+	var kept []*github.Repository
+	filtered := 0
+	for _, repo := range repos {
+		if f.Match(repo) {
+			kept = append(kept, repo)
+		} else {
+			filtered++
+		}
+	}
+	return kept, filtered
+}
+
+func (f RepoFilter) Match(repo *github.Repository) bool {
+	names := []string{repo.GetName(), repo.GetFullName()}
+
+	if len(f.Include) > 0 && !matchesAnyGlob(f.Include, names...) {
+		return false
+	}
+	if matchesAnyGlob(f.Exclude, names...) {
+		return false
+	}
+	if f.SkipArchived && repo.GetArchived() {
+		return false
+	}
+	if f.SkipTemplates && repo.GetIsTemplate() {
+		return false
+	}
+	if repo.GetStargazersCount() < f.MinStars {
+		return false
+	}
+
+	if len(f.Topics) > 0 {
+		found := false
+		for _, topic := range repo.Topics {
+			if containsFold(f.Topics, topic) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	for _, topic := range repo.Topics {
+		if containsFold(f.ExcludeTopics, topic) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,33 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/google/go-github/v81/github"
+)
+
+func TestRepoAffiliation(t *testing.T) {
+	repo := func(owner, ownerType string) *github.Repository {
+		return &github.Repository{Owner: &github.User{Login: github.Ptr(owner), Type: github.Ptr(ownerType)}}
+	}
+
+	tests := []struct {
+		name       string
+		repo       *github.Repository
+		memberOrgs []string
+		want       string
+	}{
+		{"own repository", repo("Octocat", "User"), nil, AffiliationOwner},
+		{"member organization", repo("acme", "Organization"), []string{"Acme"}, AffiliationOrganizationMember},
+		{"organization without membership", repo("acme", "Organization"), []string{"other"}, AffiliationCollaborator},
+		{"another user's repository", repo("hubot", "User"), []string{"acme"}, AffiliationCollaborator},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RepoAffiliation(tt.repo, "octocat", tt.memberOrgs); got != tt.want {
+				t.Errorf("RepoAffiliation() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
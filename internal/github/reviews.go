@@ -0,0 +1,243 @@
+package github
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+type reviewContributionsResponse struct {
+	User struct {
+		ContributionsCollection struct {
+			PullRequestReviewContributions struct {
+				TotalCount int                 `json:"totalCount"`
+				Nodes      []reviewContribNode `json:"nodes"`
+				PageInfo   pageInfo            `json:"pageInfo"`
+			} `json:"pullRequestReviewContributions"`
+		} `json:"contributionsCollection"`
+	} `json:"user"`
+}
+
+type reviewContribNode struct {
+	OccurredAt        time.Time `json:"occurredAt"`
+	PullRequestReview struct {
+		State       string     `json:"state"`
+		SubmittedAt *time.Time `json:"submittedAt"`
+		Comments    struct {
+			TotalCount int `json:"totalCount"`
+		} `json:"comments"`
+	} `json:"pullRequestReview"`
+	PullRequest struct {
+		CreatedAt time.Time `json:"createdAt"`
+		Author    *struct {
+			Login string `json:"login"`
+		} `json:"author"`
+		Repository struct {
+			NameWithOwner  string `json:"nameWithOwner"`
+			StargazerCount int    `json:"stargazerCount"`
+		} `json:"repository"`
+		TimelineItems struct {
+			Nodes []struct {
+				CreatedAt         time.Time `json:"createdAt"`
+				RequestedReviewer *struct {
+					Login string `json:"login"`
+				} `json:"requestedReviewer"`
+			} `json:"nodes"`
+		} `json:"timelineItems"`
+	} `json:"pullRequest"`
+}
+
+func (c *Client) GetUserReviews(username string, since time.Time) (*ReviewStats, error) {
+	stats, _, err := c.GetReviewHistory(username, since)
+	return stats, err
+}
+
+func (c *Client) GetReviewHistory(username string, since time.Time) (*ReviewStats, []ReviewDetail, error) {
+	var details []ReviewDetail
+	var byYear []YearCount
+	total := 0
+
+	for _, window := range yearWindows(since.UTC(), time.Now().UTC()) {
+		count, windowDetails, err := c.GetReviewDetails(username, window.From, window.To)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get reviews for %d: %w", window.Year, err)
+		}
+
+		total += count
+		details = append(details, windowDetails...)
+		byYear = append(byYear, YearCount{Year: window.Year, Count: count})
+	}
+
+	stats := summarizeReviews(details)
+	stats.Total = total
+	stats.ByYear = byYear
+	return stats, details, nil
+}
+
+func (c *Client) GetReviewDetails(username string, from, to time.Time) (int, []ReviewDetail, error) {
+	query := `
+		query($username: String!, $from: DateTime!, $to: DateTime!, $after: String) {
+			user(login: $username) {
+				contributionsCollection(from: $from, to: $to) {
+					pullRequestReviewContributions(first: 50, after: $after) {
+						totalCount
+						nodes {
+							occurredAt
+							pullRequestReview {
+								state
+								submittedAt
+								comments {
+									totalCount
+								}
+							}
+							pullRequest {
+								createdAt
+								author {
+									login
+								}
+								repository {
+									nameWithOwner
+									stargazerCount
+								}
+								timelineItems(itemTypes: [REVIEW_REQUESTED_EVENT], first: 20) {
+									nodes {
+										... on ReviewRequestedEvent {
+											createdAt
+											requestedReviewer {
+												... on User {
+													login
+												}
+											}
+										}
+									}
+								}
+							}
+						}
+						pageInfo {
+							hasNextPage
+							endCursor
+						}
+					}
+				}
+			}
+		}
+	`
+
+	total := 0
+	var details []ReviewDetail
+	var cursor *string
+
+	for {
+		variables := map[string]interface{}{
+			"username": username,
+			"from":     from.Format(time.RFC3339),
+			"to":       to.Format(time.RFC3339),
+		}
+		if cursor != nil {
+			variables["after"] = *cursor
+		}
+
+		var result reviewContributionsResponse
+		if err := c.executeGraphQL(query, variables, &result); err != nil {
+			return 0, nil, err
+		}
+
+		contributions := result.User.ContributionsCollection.PullRequestReviewContributions
+
+		if total == 0 {
+			total = contributions.TotalCount
+		}
+
+		for _, node := range contributions.Nodes {
+			details = append(details, node.toDetail(username))
+		}
+
+		if !contributions.PageInfo.HasNextPage {
+			break
+		}
+		cursor = &contributions.PageInfo.EndCursor
+	}
+
+	return total, details, nil
+}
+
+func (n reviewContribNode) toDetail(username string) ReviewDetail {
+	detail := ReviewDetail{
+		Repository:  n.PullRequest.Repository.NameWithOwner,
+		RepoStars:   n.PullRequest.Repository.StargazerCount,
+		State:       n.PullRequestReview.State,
+		SubmittedAt: n.OccurredAt,
+		PRCreatedAt: n.PullRequest.CreatedAt,
+		Comments:    n.PullRequestReview.Comments.TotalCount,
+	}
+	if n.PullRequestReview.SubmittedAt != nil {
+		detail.SubmittedAt = *n.PullRequestReview.SubmittedAt
+	}
+	if n.PullRequest.Author != nil {
+		detail.PRAuthor = n.PullRequest.Author.Login
+	}
+
+	for _, event := range n.PullRequest.TimelineItems.Nodes {
+		if event.RequestedReviewer == nil || !strings.EqualFold(event.RequestedReviewer.Login, username) {
+			continue
+		}
+		if event.CreatedAt.After(detail.SubmittedAt) {
+			continue
+		}
+		if detail.RequestedAt.IsZero() || event.CreatedAt.Before(detail.RequestedAt) {
+			detail.RequestedAt = event.CreatedAt
+		}
+	}
+
+	return detail
+}
+
+func summarizeReviews(details []ReviewDetail) *ReviewStats {
+	stats := &ReviewStats{
+		TopRepos:     make([]RepoCount, 0),
+		TopReviewees: make([]UserCount, 0),
+	}
+
+	repoCount := make(map[string]int)
+	revieweeCount := make(map[string]int)
+	var turnarounds []time.Duration
+
+	for _, review := range details {
+		repoCount[review.Repository]++
+		if review.PRAuthor != "" {
+			revieweeCount[review.PRAuthor]++
+		}
+
+		switch review.State {
+		case "APPROVED":
+			stats.Approved++
+		case "CHANGES_REQUESTED":
+			stats.ChangesRequested++
+		case "COMMENTED":
+			stats.Commented++
+		case "DISMISSED":
+			stats.Dismissed++
+		}
+
+		stats.TotalComments += review.Comments
+
+		start := review.PRCreatedAt
+		if !review.RequestedAt.IsZero() {
+			start = review.RequestedAt
+		}
+		if !start.IsZero() && review.SubmittedAt.After(start) {
+			turnarounds = append(turnarounds, review.SubmittedAt.Sub(start))
+		}
+	}
+
+	if len(details) > 0 {
+		stats.ApprovalRatio = float64(stats.Approved) / float64(len(details))
+		stats.AvgComments = float64(stats.TotalComments) / float64(len(details))
+	}
+
+	stats.Turnaround = summarizeDurations(turnarounds)
+	stats.TopRepos = getTopRepos(repoCount, 5)
+	stats.TopReviewees = getTopUsers(revieweeCount, 5)
+
+	return stats
+}
@@ -0,0 +1,221 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/v81/github"
+)
+
+type stargazersResponse struct {
+	Repository struct {
+		Stargazers struct {
+			Edges []struct {
+				StarredAt time.Time `json:"starredAt"`
+			} `json:"edges"`
+			PageInfo pageInfo `json:"pageInfo"`
+		} `json:"stargazers"`
+	} `json:"repository"`
+}
+
+func (c *Client) GetStargazerTimes(owner, name string) ([]time.Time, error) {
+	query := `
+		query($owner: String!, $name: String!, $after: String) {
+			repository(owner: $owner, name: $name) {
+				stargazers(first: 100, after: $after, orderBy: {field: STARRED_AT, direction: ASC}) {
+					edges {
+						starredAt
+					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+	`
+
+	var times []time.Time
+	var cursor *string
+
+	for {
+		variables := map[string]interface{}{
+			"owner": owner,
+			"name":  name,
+		}
+		if cursor != nil {
+			variables["after"] = *cursor
+		}
+
+		var result stargazersResponse
+		if err := c.executeGraphQL(query, variables, &result); err != nil {
+			return nil, fmt.Errorf("failed to get stargazers for %s/%s: %w", owner, name, err)
+		}
+
+		stargazers := result.Repository.Stargazers
+		for _, edge := range stargazers.Edges {
+			times = append(times, edge.StarredAt)
+		}
+
+		if !stargazers.PageInfo.HasNextPage {
+			break
+		}
+		cursor = &stargazers.PageInfo.EndCursor
+	}
+
+	return times, nil
+}
+
+func (c *Client) GetRepoStargazers(repos []*github.Repository) (map[string][]time.Time, error) {
+	stargazers := make(map[string][]time.Time)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	sem := make(chan struct{}, c.maxWorkers)
+	errChan := make(chan error, len(repos))
+
+	for _, repo := range repos {
+		if repo.GetStargazersCount() == 0 {
+			continue
+		}
+
+		wg.Add(1)
+		go func(r *github.Repository) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			times, err := c.GetStargazerTimes(r.GetOwner().GetLogin(), r.GetName())
+			if err != nil {
+				errChan <- err
+				return
+			}
+
+			mu.Lock()
+			stargazers[r.GetFullName()] = times
+			mu.Unlock()
+		}(repo)
+	}
+
+	wg.Wait()
+	close(errChan)
+
+	var firstErr error
+	for err := range errChan {
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return stargazers, firstErr
+}
+
+func (s *StatsCalculator) CalculateStarHistory(ctx context.Context, username string) (*StarHistory, error) {
+	repos, err := s.client.GetRepositories(username, s.opts.Repos)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repositories: %w", err)
+	}
+	repos, _ = s.opts.Filter.Apply(repos)
+
+	var owned []*github.Repository
+	for _, repo := range repos {
+		if repo.GetFork() || RepoAffiliation(repo, username, nil) != AffiliationOwner {
+			continue
+		}
+		owned = append(owned, repo)
+	}
+
+	stargazers, err := s.client.GetRepoStargazers(owned)
+	if err != nil {
+		if len(stargazers) == 0 {
+			return nil, err
+		}
+		warnf("failed to get complete star history: %v", err)
+	}
+
+	return buildStarHistory(username, stargazers, time.Now().UTC()), nil
+}
+
+func buildStarHistory(username string, stargazers map[string][]time.Time, now time.Time) *StarHistory {
+	history := &StarHistory{
+		Username: username,
+		Repos:    make([]RepoStars, 0),
+		Monthly:  make([]StarPoint, 0),
+	}
+
+	last30 := now.AddDate(0, 0, -30)
+	last90 := now.AddDate(0, 0, -90)
+	monthly := make(map[string]int)
+	var first time.Time
+
+	for name, times := range stargazers {
+		repo := RepoStars{
+			Name:  name,
+			Stars: len(times),
+		}
+
+		for _, t := range times {
+			t = t.UTC()
+			monthly[t.Format("2006-01")]++
+
+			if t.After(last30) {
+				repo.Last30Days++
+			}
+			if t.After(last90) {
+				repo.Last90Days++
+			}
+			if repo.FirstStar.IsZero() || t.Before(repo.FirstStar) {
+				repo.FirstStar = t
+			}
+			if t.After(repo.LastStar) {
+				repo.LastStar = t
+			}
+		}
+
+		if !repo.FirstStar.IsZero() && (first.IsZero() || repo.FirstStar.Before(first)) {
+			first = repo.FirstStar
+		}
+
+		history.TotalStars += repo.Stars
+		history.Last30Days += repo.Last30Days
+		history.Last90Days += repo.Last90Days
+		history.Repos = append(history.Repos, repo)
+	}
+
+	sort.Slice(history.Repos, func(i, j int) bool {
+		if history.Repos[i].Last90Days != history.Repos[j].Last90Days {
+			return history.Repos[i].Last90Days > history.Repos[j].Last90Days
+		}
+		if history.Repos[i].Stars != history.Repos[j].Stars {
+			return history.Repos[i].Stars > history.Repos[j].Stars
+		}
+		return history.Repos[i].Name < history.Repos[j].Name
+	})
+
+	if len(history.Repos) > 0 && history.Repos[0].Last90Days > 0 {
+		history.FastestGrowing = history.Repos[0].Name
+		history.FastestGrowingGain = history.Repos[0].Last90Days
+	}
+
+	if first.IsZero() {
+		return history
+	}
+
+	total := 0
+	month := time.Date(first.Year(), first.Month(), 1, 0, 0, 0, 0, time.UTC)
+	for !month.After(now) {
+		key := month.Format("2006-01")
+		total += monthly[key]
+		history.Monthly = append(history.Monthly, StarPoint{
+			Month:  key,
+			Gained: monthly[key],
+			Total:  total,
+		})
+		month = month.AddDate(0, 1, 0)
+	}
+
+	return history
+}
@@ -0,0 +1,219 @@
+package github
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/go-github/v81/github"
+)
+
+// stateOverlap re-fetches a short window before the last run so items
+// updated while that run was in flight are not missed.
+const stateOverlap = time.Hour
+
+type IncrementalState struct {
+	Username        string
+	UpdatedAt       time.Time
+	Repos           map[string]*RepoState
+	PullRequests    map[string]PullRequestDetail
+	PRsFetchedAt    time.Time
+	Issues          map[string]IssueDetail
+	IssuesFetchedAt time.Time
+}
+
+type RepoState struct {
+	LanguagesPushedAt time.Time
+	Languages         map[string]int64
+	CommitsPushedAt   time.Time
+	CommitsFetchedAt  time.Time
+	Commits           map[string]time.Time
+}
+
+func NewIncrementalState(username string) *IncrementalState {
+	return &IncrementalState{
+		Username:     username,
+		Repos:        make(map[string]*RepoState),
+		PullRequests: make(map[string]PullRequestDetail),
+		Issues:       make(map[string]IssueDetail),
+	}
+}
+
+// SetState makes repository, commit, pull request and issue fetching
+// incremental against state, which is updated in place as data arrives.
+// Passing nil restores full fetching.
+func (c *Client) SetState(state *IncrementalState) {
+	c.stateMu.Lock()
+	defer c.stateMu.Unlock()
+	if state != nil {
+		if state.Repos == nil {
+			state.Repos = make(map[string]*RepoState)
+		}
+		if state.PullRequests == nil {
+			state.PullRequests = make(map[string]PullRequestDetail)
+		}
+		if state.Issues == nil {
+			state.Issues = make(map[string]IssueDetail)
+		}
+	}
+	c.state = state
+}
+
+func (c *Client) hasState() bool {
+	c.stateMu.Lock()
+	defer c.stateMu.Unlock()
+	return c.state != nil
+}
+
+func (c *Client) repoState(fullName string) *RepoState {
+	repo, ok := c.state.Repos[fullName]
+	if !ok {
+		repo = &RepoState{}
+		c.state.Repos[fullName] = repo
+	}
+	return repo
+}
+
+func (c *Client) cachedRepoLanguages(r *github.Repository) (map[string]int64, bool) {
+	c.stateMu.Lock()
+	defer c.stateMu.Unlock()
+	if c.state == nil {
+		return nil, false
+	}
+	repo, ok := c.state.Repos[r.GetFullName()]
+	if !ok || repo.Languages == nil || !repo.LanguagesPushedAt.Equal(r.GetPushedAt().Time) {
+		return nil, false
+	}
+	return repo.Languages, true
+}
+
+func (c *Client) storeRepoLanguages(r *github.Repository, languages map[string]int64) {
+	c.stateMu.Lock()
+	defer c.stateMu.Unlock()
+	if c.state == nil {
+		return
+	}
+	repo := c.repoState(r.GetFullName())
+	repo.Languages = languages
+	repo.LanguagesPushedAt = r.GetPushedAt().Time
+}
+
+// repoCommitDates returns the author's commit dates in a repository.
+func (c *Client) repoCommitDates(author string, r *github.Repository) ([]time.Time, error) {
+	commits, err := c.repoCommits(author, r)
+	if err != nil {
+		return nil, err
+	}
+	return sortedCommitDates(commits), nil
+}
+
+// repoCommits returns the author's commits in a repository keyed by SHA.
+// With state attached, repositories that have not been pushed to since the
+// last run are served from state and the rest only fetch commits since then.
+func (c *Client) repoCommits(author string, r *github.Repository) (map[string]time.Time, error) {
+	c.stateMu.Lock()
+	if c.state == nil {
+		c.stateMu.Unlock()
+		return c.listRepoCommits(author, r.GetOwner().GetLogin(), r.GetName(), time.Time{})
+	}
+	pushedAt := r.GetPushedAt().Time
+	stored := c.repoState(r.GetFullName())
+	commits := make(map[string]time.Time, len(stored.Commits))
+	for sha, date := range stored.Commits {
+		commits[sha] = date
+	}
+	unchanged := stored.Commits != nil && stored.CommitsPushedAt.Equal(pushedAt)
+	var since time.Time
+	if stored.Commits != nil {
+		since = stored.CommitsFetchedAt.Add(-stateOverlap)
+	}
+	c.stateMu.Unlock()
+
+	if !unchanged {
+		fetchedAt := time.Now().UTC()
+		fetched, err := c.listRepoCommits(author, r.GetOwner().GetLogin(), r.GetName(), since)
+		if err != nil {
+			return nil, err
+		}
+		for sha, date := range fetched {
+			commits[sha] = date
+		}
+		c.storeRepoCommits(r.GetFullName(), commits, pushedAt, fetchedAt)
+	}
+
+	return commits, nil
+}
+
+// storeRepoCommits records a repository's complete commit list in state as
+// of fetchedAt, when the repository was last pushed at pushedAt.
+func (c *Client) storeRepoCommits(fullName string, commits map[string]time.Time, pushedAt, fetchedAt time.Time) {
+	c.stateMu.Lock()
+	defer c.stateMu.Unlock()
+	if c.state == nil {
+		return
+	}
+	stored := c.repoState(fullName)
+	stored.Commits = commits
+	stored.CommitsPushedAt = pushedAt
+	stored.CommitsFetchedAt = fetchedAt
+}
+
+func (c *Client) incrementalPullRequests(username string) ([]PullRequestDetail, error) {
+	c.stateMu.Lock()
+	previous := c.state.PRsFetchedAt
+	c.stateMu.Unlock()
+
+	fetchedAt := time.Now().UTC()
+	search := fmt.Sprintf("author:%s is:pr sort:created-desc", username)
+	if !previous.IsZero() {
+		search += " updated:>=" + previous.Add(-stateOverlap).Format(time.RFC3339)
+	}
+	updated, err := c.SearchPullRequests(search)
+	if err != nil {
+		return nil, err
+	}
+
+	c.stateMu.Lock()
+	defer c.stateMu.Unlock()
+	for _, pr := range updated {
+		c.state.PullRequests[pr.URL] = pr
+	}
+	c.state.PRsFetchedAt = fetchedAt
+
+	details := make([]PullRequestDetail, 0, len(c.state.PullRequests))
+	for _, pr := range c.state.PullRequests {
+		details = append(details, pr)
+	}
+	sort.Slice(details, func(i, j int) bool { return details[i].CreatedAt.After(details[j].CreatedAt) })
+	return details, nil
+}
+
+func (c *Client) incrementalIssues(username string) ([]IssueDetail, error) {
+	c.stateMu.Lock()
+	previous := c.state.IssuesFetchedAt
+	c.stateMu.Unlock()
+
+	fetchedAt := time.Now().UTC()
+	search := fmt.Sprintf("author:%s is:issue sort:created-desc", username)
+	if !previous.IsZero() {
+		search += " updated:>=" + previous.Add(-stateOverlap).Format(time.RFC3339)
+	}
+	updated, err := c.SearchIssues(search)
+	if err != nil {
+		return nil, err
+	}
+
+	c.stateMu.Lock()
+	defer c.stateMu.Unlock()
+	for _, issue := range updated {
+		c.state.Issues[issue.URL] = issue
+	}
+	c.state.IssuesFetchedAt = fetchedAt
+
+	details := make([]IssueDetail, 0, len(c.state.Issues))
+	for _, issue := range c.state.Issues {
+		details = append(details, issue)
+	}
+	sort.Slice(details, func(i, j int) bool { return details[i].CreatedAt.After(details[j].CreatedAt) })
+	return details, nil
+}
@@ -0,0 +1,133 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v81/github"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client := NewClient(context.Background(), "token", 2)
+	baseURL, err := url.Parse(server.URL + "/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.client.BaseURL = baseURL
+	return client
+}
+
+func testRepo(pushedAt time.Time) *github.Repository {
+	return &github.Repository{
+		Name:     github.Ptr("repo"),
+		FullName: github.Ptr("me/repo"),
+		Owner:    &github.User{Login: github.Ptr("me")},
+		PushedAt: &github.Timestamp{Time: pushedAt},
+	}
+}
+
+func commitPage(shas ...string) string {
+	body := "["
+	for i, sha := range shas {
+		if i > 0 {
+			body += ","
+		}
+		body += fmt.Sprintf(`{"sha":%q,"commit":{"author":{"date":"2026-01-0%dT00:00:00Z"}}}`, sha, i+1)
+	}
+	return body + "]"
+}
+
+func TestListRepoCommitsErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{"empty repository", http.StatusConflict, false},
+		{"missing repository", http.StatusNotFound, false},
+		{"server error", http.StatusInternalServerError, true},
+		{"forbidden", http.StatusForbidden, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = fmt.Fprint(w, `{"message":"error"}`)
+			})
+
+			commits, err := client.listRepoCommits("me", "me", "repo", time.Time{})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("listRepoCommits() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && len(commits) != 0 {
+				t.Errorf("listRepoCommits() = %v, want no commits", commits)
+			}
+
+			count, err := client.countRepoCommits("me", "me", "repo")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("countRepoCommits() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && count != 0 {
+				t.Errorf("countRepoCommits() = %d, want 0", count)
+			}
+		})
+	}
+}
+
+func TestRepoCommitDatesKeepsStateOnPartialFetch(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Header().Set("Link", fmt.Sprintf(`<%s?page=2>; rel="next"`, r.URL.Path))
+		_, _ = fmt.Fprint(w, commitPage("a", "b"))
+	})
+
+	pushedAt := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	state := NewIncrementalState("me")
+	client.SetState(state)
+
+	if _, err := client.repoCommitDates("me", testRepo(pushedAt)); err == nil {
+		t.Fatal("repoCommitDates() error = nil, want error for failed page")
+	}
+
+	stored := state.Repos["me/repo"]
+	if stored != nil && (stored.Commits != nil || stored.CommitsPushedAt.Equal(pushedAt)) {
+		t.Errorf("state updated after failed fetch: %+v", stored)
+	}
+}
+
+func TestRepoCommitDatesServesUnchangedRepoFromState(t *testing.T) {
+	requests := 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, _ = fmt.Fprint(w, commitPage("a", "b"))
+	})
+
+	pushedAt := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	client.SetState(NewIncrementalState("me"))
+
+	for i := 0; i < 2; i++ {
+		dates, err := client.repoCommitDates("me", testRepo(pushedAt))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(dates) != 2 {
+			t.Fatalf("run %d: got %d dates, want 2", i+1, len(dates))
+		}
+	}
+	if requests != 1 {
+		t.Errorf("made %d requests, want 1 (second run served from state)", requests)
+	}
+}
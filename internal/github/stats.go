@@ -10,15 +10,42 @@ import (
 	"github.com/google/go-github/v81/github"
 )
 
+type Options struct {
+	FullScan       bool
+	ActivitySource string
+	Languages      LanguageOptions
+	Repos          RepoListOptions
+	Filter         RepoFilter
+	TopN           int
+	TopBy          string
+}
+
+const (
+	TopByStars   = "stars"
+	TopByForks   = "forks"
+	TopByPushed  = "pushed"
+	TopByCommits = "commits"
+	TopBySize    = "size"
+	TopByIssues  = "issues"
+)
+
+const (
+	// Follower logins are only recorded up to this many followers, as
+	// listing them costs a request per hundred.
+	maxFollowerList    = 5000
+	recentMergedWindow = 90 * 24 * time.Hour
+)
+
 type StatsCalculator struct {
 	client *Client
+	opts   Options
 }
 
-func NewStatsCalculator(client *Client) *StatsCalculator {
-	return &StatsCalculator{client: client}
+func NewStatsCalculator(client *Client, opts Options) *StatsCalculator {
+	return &StatsCalculator{client: client, opts: opts}
 }
 
-func (s *StatsCalculator) Calculate(ctx context.Context, username string, fullScan bool) (*UserStats, error) {
+func (s *StatsCalculator) Calculate(ctx context.Context, username string) (*UserStats, error) {
 	stats := &UserStats{
 		Username:  username,
 		Languages: make(map[string]int64),
@@ -31,23 +58,33 @@ func (s *StatsCalculator) Calculate(ctx context.Context, username string, fullSc
 
 	s.populateProfile(stats, user)
 
-	repos, err := s.client.GetRepositories(username)
+	repos, err := s.client.GetRepositories(username, s.opts.Repos)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get repositories: %w", err)
 	}
+	repos, stats.FilteredRepos = s.opts.Filter.Apply(repos)
 
-	s.calculateRepoStats(stats, repos)
+	memberOrgs := s.memberOrgs(username, repos)
+	s.calculateRepoStats(stats, repos, memberOrgs)
 
-	languages, err := s.client.GetLanguages(repos)
+	repoLanguages, err := s.client.GetLanguages(repos, s.opts.Languages)
 	if err != nil {
-		fmt.Printf("Warning: failed to get complete language stats: %v\n", err)
+		warnf("failed to get complete language stats: %v", err)
 	}
-	stats.Languages = languages
+	stats.Languages = aggregateLanguages(repoLanguages, s.opts.Languages)
+	stats.LanguageWeighting = s.opts.Languages.Weighting
 
-	commitDates, err := s.client.GetCommitActivity(username, fullScan)
+	activity, err := s.client.GetCommitActivity(username, repos, stats.CreatedAt, s.opts.ActivitySource, s.opts.FullScan)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get commit activity: %w", err)
 	}
+	commitDates := activity.Dates
+	stats.ActivitySource = activity.Source
+	stats.CommitScanComplete = activity.ScanComplete
+	stats.CalendarYears = activity.FetchedYears
+	stats.CalendarFailedYears = activity.FailedYears
+	stats.ActivityProvenance = activity.Provenance
+	stats.LanguageTimeline = buildLanguageTimeline(repos, repoLanguages, activity.RepoCommits, s.opts.Languages)
 
 	streakInfo := s.calculateStreaks(commitDates)
 	stats.CurrentStreak = streakInfo.CurrentStreak
@@ -58,26 +95,49 @@ func (s *StatsCalculator) Calculate(ctx context.Context, username string, fullSc
 	stats.TotalCommitDays = len(streakInfo.CommitDates)
 
 	s.calculateActivityPatterns(stats, commitDates)
-	s.calculateTopRepositories(stats, repos)
+	s.calculateTopRepositories(stats, repos, memberOrgs, activity.RepoCommits)
+
+	since := stats.CreatedAt
+	if since.IsZero() {
+		since = time.Now().AddDate(-1, 0, 0)
+	}
 
+	var prDetails []PullRequestDetail
+	var reviewDetails []ReviewDetail
+	var repoCommits []RepoContribution
 	var wg sync.WaitGroup
-	wg.Add(3)
+	wg.Add(5)
 
 	go func() {
 		defer wg.Done()
-		prStats, err := s.client.GetUserPullRequests(username)
+		details, err := s.client.GetPullRequestDetails(username)
+		if err != nil {
+			warnf("failed to get PR stats: %v", err)
+			return
+		}
+		prDetails = details
+		stats.PRStats = summarizePullRequests(details)
+		stats.RecentMergedPRs = recentMergedPRs(details, time.Now().Add(-recentMergedWindow))
+	}()
+
+	go func() {
+		defer wg.Done()
+		if stats.Followers > maxFollowerList {
+			return
+		}
+		followers, err := s.client.GetFollowers(username)
 		if err != nil {
-			fmt.Printf("Warning: failed to get PR stats: %v\n", err)
+			warnf("failed to get followers: %v", err)
 			return
 		}
-		stats.PRStats = prStats
+		stats.FollowerLogins = followers
 	}()
 
 	go func() {
 		defer wg.Done()
 		issueStats, err := s.client.GetUserIssues(username)
 		if err != nil {
-			fmt.Printf("Warning: failed to get issue stats: %v\n", err)
+			warnf("failed to get issue stats: %v", err)
 			return
 		}
 		stats.IssueStats = issueStats
@@ -85,19 +145,61 @@ func (s *StatsCalculator) Calculate(ctx context.Context, username string, fullSc
 
 	go func() {
 		defer wg.Done()
-		reviewStats, err := s.client.GetUserReviews(username)
+		reviewStats, details, err := s.client.GetReviewHistory(username, since)
 		if err != nil {
-			fmt.Printf("Warning: failed to get review stats: %v\n", err)
+			warnf("failed to get review stats: %v", err)
 			return
 		}
+		reviewDetails = details
 		stats.ReviewStats = reviewStats
 	}()
 
+	go func() {
+		defer wg.Done()
+		contributions, unattributed, err := s.client.GetCommitContributionsByRepo(username, since)
+		if err != nil {
+			warnf("failed to get commit contributions: %v", err)
+			return
+		}
+		repoCommits = contributions
+		stats.UnattributedCommits = unattributed
+	}()
+
 	wg.Wait()
 
+	for _, repo := range repoCommits {
+		if isOwnRepo(repo.RepoName, username) {
+			stats.OwnRepoCommits += repo.Commits
+		} else {
+			stats.OtherRepoCommits += repo.Commits
+		}
+	}
+	stats.ExternalContributions = buildExternalContributions(username, prDetails, reviewDetails, repoCommits)
+	stats.Collaborators = buildCollaborators(username, prDetails, reviewDetails)
+
 	return stats, nil
 }
 
+// recentMergedPRs keeps the PRs merged since cutoff so a later run can list
+// the ones merged in between.
+func recentMergedPRs(details []PullRequestDetail, cutoff time.Time) []MergedPR {
+	merged := make([]MergedPR, 0)
+	for _, pr := range details {
+		if pr.State != "MERGED" || pr.MergedAt.Before(cutoff) {
+			continue
+		}
+		merged = append(merged, MergedPR{
+			Repository: pr.Repository,
+			Number:     pr.Number,
+			Title:      pr.Title,
+			URL:        pr.URL,
+			MergedAt:   pr.MergedAt,
+		})
+	}
+	sort.Slice(merged, func(i, j int) bool { return merged[i].MergedAt.After(merged[j].MergedAt) })
+	return merged
+}
+
 func (s *StatsCalculator) populateProfile(stats *UserStats, user *github.User) {
 	if user.Name != nil {
 		stats.Name = *user.Name
@@ -138,10 +240,30 @@ func (s *StatsCalculator) populateProfile(stats *UserStats, user *github.User) {
 	}
 }
 
-func (s *StatsCalculator) calculateRepoStats(stats *UserStats, repos []*github.Repository) {
+// memberOrgs looks up the user's organizations only when an organization
+// owns one of the repositories, since it costs an extra request.
+func (s *StatsCalculator) memberOrgs(username string, repos []*github.Repository) []string {
+	for _, repo := range repos {
+		if repo.GetOwner().GetType() != "Organization" {
+			continue
+		}
+		orgs, err := s.client.GetUserOrgs(username)
+		if err != nil {
+			warnf("failed to get organizations: %v", err)
+		}
+		return orgs
+	}
+	return nil
+}
+
+func (s *StatsCalculator) calculateRepoStats(stats *UserStats, repos []*github.Repository, memberOrgs []string) {
+	stats.ReposByAffiliation = make(map[string]int)
+	stats.RepoStars = make(map[string]int)
 	for _, repo := range repos {
+		stats.ReposByAffiliation[RepoAffiliation(repo, stats.Username, memberOrgs)]++
 		if repo.StargazersCount != nil {
 			stats.TotalStars += *repo.StargazersCount
+			stats.RepoStars[repo.GetFullName()] = *repo.StargazersCount
 		}
 		if repo.ForksCount != nil {
 			stats.TotalForks += *repo.ForksCount
@@ -260,20 +382,49 @@ func (s *StatsCalculator) calculateActivityPatterns(stats *UserStats, commitDate
 	}
 }
 
-func (s *StatsCalculator) calculateTopRepositories(stats *UserStats, repos []*github.Repository) {
+func (s *StatsCalculator) calculateTopRepositories(stats *UserStats, repos []*github.Repository, memberOrgs []string, repoCommits map[string][]time.Time) {
 	var repoList []Repository
+	var ownRepos []*github.Repository
 
 	for _, repo := range repos {
 		if repo.Fork != nil && *repo.Fork {
 			continue
 		}
+		ownRepos = append(ownRepos, repo)
+	}
+
+	topBy := s.opts.TopBy
+	if topBy == "" {
+		topBy = TopByStars
+	}
 
+	var commitCounts map[string]int
+	if topBy == TopByCommits {
+		if len(repoCommits) > 0 {
+			commitCounts = make(map[string]int)
+			for name, dates := range repoCommits {
+				commitCounts[name] = len(dates)
+			}
+		} else {
+			var err error
+			commitCounts, err = s.client.GetRepoCommitCounts(stats.Username, ownRepos)
+			if err != nil {
+				warnf("failed to get complete commit counts: %v", err)
+			}
+		}
+	}
+
+	for _, repo := range ownRepos {
 		r := Repository{
-			IsForked: false,
+			IsForked:    false,
+			Affiliation: RepoAffiliation(repo, stats.Username, memberOrgs),
 		}
 		if repo.Name != nil {
 			r.Name = *repo.Name
 		}
+		if repo.FullName != nil {
+			r.FullName = *repo.FullName
+		}
 		if repo.Description != nil {
 			r.Description = *repo.Description
 		}
@@ -292,18 +443,66 @@ func (s *StatsCalculator) calculateTopRepositories(stats *UserStats, repos []*gi
 		if repo.UpdatedAt != nil {
 			r.UpdatedAt = repo.UpdatedAt.Time
 		}
+		if repo.PushedAt != nil {
+			r.PushedAt = repo.PushedAt.Time
+		}
+		if repo.OpenIssuesCount != nil {
+			r.OpenIssues = *repo.OpenIssuesCount
+		}
+		if repo.Size != nil {
+			r.Size = *repo.Size
+		}
+		if repo.Archived != nil {
+			r.Archived = *repo.Archived
+		}
+		if repo.License != nil && repo.License.SPDXID != nil {
+			r.License = *repo.License.SPDXID
+		}
+		r.Topics = repo.Topics
+		r.Commits = commitCounts[r.FullName]
 
 		repoList = append(repoList, r)
 	}
 
-	sort.Slice(repoList, func(i, j int) bool {
-		return repoList[i].Stars > repoList[j].Stars
+	sort.SliceStable(repoList, func(i, j int) bool {
+		a, b := repoList[i], repoList[j]
+		switch topBy {
+		case TopByForks:
+			return a.Forks > b.Forks
+		case TopByPushed:
+			return a.PushedAt.After(b.PushedAt)
+		case TopByCommits:
+			return a.Commits > b.Commits
+		case TopBySize:
+			return a.Size > b.Size
+		case TopByIssues:
+			return a.OpenIssues > b.OpenIssues
+		default:
+			return a.Stars > b.Stars
+		}
 	})
 
-	if len(repoList) > 5 {
-		stats.TopRepositories = repoList[:5]
-	} else {
-		stats.TopRepositories = repoList
+	limit := s.opts.TopN
+	if limit <= 0 {
+		limit = 5
+	}
+
+	stats.TopRepositoriesBy = topBy
+	if len(repoList) > limit {
+		repoList = repoList[:limit]
+	}
+	stats.TopRepositories = repoList
+
+	fullNames := make([]string, len(repoList))
+	for i, repo := range repoList {
+		fullNames[i] = repo.FullName
+	}
+	watchers, err := s.client.GetWatcherCounts(fullNames)
+	if err != nil {
+		warnf("failed to get watcher counts: %v", err)
+	}
+	for i := range repoList {
+		repoList[i].Watchers = watchers[repoList[i].FullName]
 	}
 }
 
@@ -20,31 +20,59 @@ type UserStats struct {
 	Followers   int
 	Following   int
 
-	AccountAge         Duration
-	TotalStars         int
-	TotalForks         int
-	CurrentStreak      int
-	MaxStreak          int
-	CurrentStreakStart time.Time
-	MaxStreakStart     time.Time
-	MaxStreakEnd       time.Time
-	TotalCommitDays    int
+	AccountAge          Duration
+	TotalStars          int
+	TotalForks          int
+	ReposByAffiliation  map[string]int
+	RepoStars           map[string]int
+	FilteredRepos       int
+	CurrentStreak       int
+	MaxStreak           int
+	CurrentStreakStart  time.Time
+	MaxStreakStart      time.Time
+	MaxStreakEnd        time.Time
+	TotalCommitDays     int
+	ActivitySource      string
+	CommitScanComplete  bool
+	CalendarYears       []int
+	CalendarFailedYears []int
+	ActivityProvenance  *ActivityProvenance
 
 	Languages            map[string]int64
+	LanguageWeighting    string
+	LanguageTimeline     *LanguageTimeline
 	MostActiveDay        string
 	MostActiveHour       int
 	TopRepositories      []Repository
+	TopRepositoriesBy    string
 	ContributionVelocity float64
 	OwnRepoCommits       int
 	OtherRepoCommits     int
+	UnattributedCommits  int
+
+	ExternalContributions []ExternalContribution
+	Collaborators         []Collaborator
 
 	PRStats     *PullRequestStats
 	IssueStats  *IssueStats
 	ReviewStats *ReviewStats
+
+	FollowerLogins  []string
+	RecentMergedPRs []MergedPR
+}
+
+type MergedPR struct {
+	Repository string
+	Number     int
+	Title      string
+	URL        string
+	MergedAt   time.Time
 }
 
 type Repository struct {
 	Name        string
+	FullName    string
+	Affiliation string
 	Description string
 	Stars       int
 	Forks       int
@@ -53,6 +81,13 @@ type Repository struct {
 	UpdatedAt   time.Time
 	IsForked    bool
 	Commits     int
+	PushedAt    time.Time
+	OpenIssues  int
+	Watchers    int
+	Size        int
+	Topics      []string
+	License     string
+	Archived    bool
 }
 
 type Duration struct {
@@ -103,23 +138,414 @@ type RepoCount struct {
 	Count    int
 }
 
+type DurationStats struct {
+	Count  int
+	Mean   time.Duration
+	Median time.Duration
+	P75    time.Duration
+	P90    time.Duration
+	Min    time.Duration
+	Max    time.Duration
+}
+
+type DurationBucket struct {
+	Label string
+	Count int
+}
+
 type PullRequestStats struct {
-	Total        int
-	Open         int
-	Closed       int
-	Merged       int
-	AvgMergeTime time.Duration
-	TopRepos     []RepoCount
+	Total              int
+	Open               int
+	Closed             int
+	Merged             int
+	AvgMergeTime       time.Duration
+	MergeTime          DurationStats
+	MergeTimeHistogram []DurationBucket
+	TopRepos           []RepoCount
+
+	AvgAdditions         float64
+	AvgDeletions         float64
+	AvgChangedFiles      float64
+	AvgCommits           float64
+	SizeBreakdown        []PRSizeStats
+	SizeMergeCorrelation float64
+	TimeToFirstReview    DurationStats
+	AvgReviewRounds      float64
+	AvgReviewers         float64
 }
 
 type IssueStats struct {
-	Total        int
-	Open         int
-	Closed       int
-	AvgCloseTime time.Duration
+	Total              int
+	Open               int
+	Closed             int
+	AvgCloseTime       time.Duration
+	CloseTime          DurationStats
+	CloseTimeHistogram []DurationBucket
+
+	TopRepos            []RepoCount
+	TopLabels           []LabelCount
+	Completed           int
+	NotPlanned          int
+	Duplicate           int
+	ClosedByAuthor      int
+	ClosedByOthers      int
+	LinkedPRs           int
+	IssuesWithLinkedPRs int
+}
+
+type LabelCount struct {
+	Name  string
+	Count int
+}
+
+type IssueDetail struct {
+	Repository      string
+	Number          int
+	Title           string
+	URL             string
+	State           string
+	StateReason     string
+	CreatedAt       time.Time
+	ClosedAt        time.Time
+	Author          string
+	ClosedBy        string
+	Labels          []string
+	LinkedPRs       int
+	FirstResponseAt time.Time
+}
+
+type UserCount struct {
+	Login string
+	Count int
 }
 
 type ReviewStats struct {
 	Total    int
 	TopRepos []RepoCount
+
+	Approved         int
+	ChangesRequested int
+	Commented        int
+	Dismissed        int
+	ApprovalRatio    float64
+	Turnaround       DurationStats
+	TopReviewees     []UserCount
+	TotalComments    int
+	AvgComments      float64
+	ByYear           []YearCount
+}
+
+type YearCount struct {
+	Year  int
+	Count int
+}
+
+type CommitActivity struct {
+	Dates        []time.Time
+	Source       string
+	FetchedYears []int
+	FailedYears  []int
+	Provenance   *ActivityProvenance
+	RepoCommits  map[string][]time.Time
+	ScanComplete bool
+}
+
+type ActivityProvenance struct {
+	Days       map[string][]string
+	SourceDays map[string]int
+	UniqueDays map[string]int
+}
+
+type ContributionCalendar struct {
+	Dates        []time.Time
+	FetchedYears []int
+	FailedYears  []int
+}
+
+type ContributionDay struct {
+	Date  time.Time
+	Count int
+}
+
+type RepoContribution struct {
+	RepoName    string
+	Language    string
+	Stars       int
+	Commits     int
+	FirstCommit time.Time
+	LastCommit  time.Time
+}
+
+type ContributionSummary struct {
+	From               time.Time
+	To                 time.Time
+	TotalContributions int
+	Commits            int
+	Issues             int
+	PullRequests       int
+	Reviews            int
+	Restricted         int
+	Days               []ContributionDay
+	Repositories       []RepoContribution
+
+	// RepositoriesTruncated is set when Repositories hit GitHub's limit,
+	// so Commits includes commits in repositories missing from it.
+	RepositoriesTruncated bool
+}
+
+type LanguageCount struct {
+	Name       string
+	Count      int
+	Percentage float64
+}
+
+type MonthCount struct {
+	Month string
+	Count int
+}
+
+type YearTotals struct {
+	Year               int
+	TotalContributions int
+	Commits            int
+	PullRequests       int
+	PRsMerged          int
+	Issues             int
+	Reviews            int
+	ActiveDays         int
+	LongestStreak      int
+}
+
+type WrappedReport struct {
+	Username string
+	Name     string
+	Totals   YearTotals
+	Previous *YearTotals
+
+	BusiestMonth       string
+	BusiestMonthCount  int
+	BusiestDay         time.Time
+	BusiestDayCount    int
+	LongestStreakStart time.Time
+	LongestStreakEnd   time.Time
+	FirstContribution  time.Time
+	LastContribution   time.Time
+
+	MonthlyContributions []MonthCount
+	TopRepos             []RepoCount
+	TopLanguages         []LanguageCount
+}
+
+type PullRequestDetail struct {
+	Repository    string
+	RepoStars     int
+	Number        int
+	Title         string
+	URL           string
+	State         string
+	CreatedAt     time.Time
+	MergedAt      time.Time
+	ClosedAt      time.Time
+	Additions     int
+	Deletions     int
+	ChangedFiles  int
+	Commits       int
+	Size          string
+	FirstReviewAt time.Time
+	ReviewRounds  int
+	Reviewers     []string
+	CoAuthors     []string
+}
+
+type PRSizeStats struct {
+	Size            string
+	Count           int
+	Merged          int
+	MedianMergeTime time.Duration
+}
+
+type ReviewDetail struct {
+	Repository  string
+	RepoStars   int
+	State       string
+	SubmittedAt time.Time
+	PRAuthor    string
+	PRCreatedAt time.Time
+	RequestedAt time.Time
+	Comments    int
+}
+
+type LanguageTimeline struct {
+	BucketBy  string
+	Years     []int
+	Languages []string
+	Series    []LanguageSeries
+}
+
+type LanguageSeries struct {
+	Language string
+	Points   []YearShare
+}
+
+type YearShare struct {
+	Year   int
+	Weight int64
+	Share  float64
+}
+
+type StarHistory struct {
+	Username           string
+	TotalStars         int
+	Last30Days         int
+	Last90Days         int
+	FastestGrowing     string
+	FastestGrowingGain int
+	Repos              []RepoStars
+	Monthly            []StarPoint
+}
+
+type RepoStars struct {
+	Name       string
+	Stars      int
+	Last30Days int
+	Last90Days int
+	FirstStar  time.Time
+	LastStar   time.Time
+}
+
+type StarPoint struct {
+	Month  string
+	Gained int
+	Total  int
+}
+
+type RepoReport struct {
+	FullName    string
+	Description string
+	Stars       int
+	Forks       int
+	Watchers    int
+	OpenIssues  int
+	Since       time.Time
+	Until       time.Time
+
+	TopContributors []UserCount
+	Commits         int
+	WeeklyCommits   []WeekCount
+	Languages       []LanguageCount
+
+	PRsOpened        int
+	PRsMerged        int
+	PRsClosed        int
+	PRsMergedPerWeek float64
+	MergeTime        DurationStats
+	PRFirstResponse  DurationStats
+	TopReviewers     []UserCount
+
+	IssuesOpened        int
+	IssuesClosed        int
+	IssuesOpenedPerWeek float64
+	IssuesClosedPerWeek float64
+	IssueCloseTime      DurationStats
+	IssueFirstResponse  DurationStats
+
+	Releases        int
+	TotalReleases   int
+	LatestRelease   string
+	LatestReleaseAt time.Time
+	ReleaseInterval DurationStats
+}
+
+type WeekCount struct {
+	Week  time.Time
+	Count int
+}
+
+type OrgReport struct {
+	Org   string
+	Team  string
+	Since time.Time
+	Until time.Time
+
+	Members            int
+	ActiveMembers      int
+	TotalContributions int
+	Commits            int
+	PullRequests       int
+	PullRequestsMerged int
+	Reviews            int
+	Issues             int
+	PRsPerWeek         float64
+	ReviewsPerWeek     float64
+
+	Languages       []LanguageCount
+	TopContributors []UserCount
+	TopReviewers    []UserCount
+	InactiveMembers []string
+	FailedMembers   []string
+	MemberStats     []MemberStats
+}
+
+type MemberStats struct {
+	Login              string
+	TotalContributions int
+	Commits            int
+	PullRequests       int
+	PullRequestsMerged int
+	Reviews            int
+	Issues             int
+	Stats              *UserStats
+}
+
+type Comparison struct {
+	Users        []string
+	TopLanguages []string
+	Metrics      []ComparisonMetric
+	Failed       map[string]string
+}
+
+type ComparisonMetric struct {
+	Name   string
+	Unit   string
+	Values []float64
+	Leader string
+}
+
+type Leaderboard struct {
+	Metric      string
+	Since       time.Time
+	HasPrevious bool
+	Entries     []LeaderboardEntry
+	Failed      map[string]string
+}
+
+type LeaderboardEntry struct {
+	Rank         int
+	Login        string
+	Score        float64
+	Tied         bool
+	Values       map[string]float64
+	PreviousRank int
+	RankChange   int
+	New          bool
+}
+
+type ExternalContribution struct {
+	Repository        string
+	Stars             int
+	MergedPRs         int
+	Commits           int
+	Reviews           int
+	FirstContribution time.Time
+	LastContribution  time.Time
+	Impact            float64
+}
+
+type Collaborator struct {
+	Login          string
+	ReviewedByThem int
+	ReviewedByUser int
+	CoAuthored     int
+	SharedRepos    []string
+	Score          int
 }
@@ -0,0 +1,173 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+func (s *StatsCalculator) CalculateWrapped(ctx context.Context, username string, year int) (*WrappedReport, error) {
+	report := &WrappedReport{
+		Username: username,
+	}
+
+	user, err := s.client.GetUser(username)
+	if err != nil {
+		return nil, err
+	}
+	if user.Name != nil {
+		report.Name = *user.Name
+	}
+
+	var current, previous *ContributionSummary
+	var currentErr, previousErr error
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+		current, currentErr = s.yearSummary(username, year)
+	}()
+
+	go func() {
+		defer wg.Done()
+		previous, previousErr = s.yearSummary(username, year-1)
+	}()
+
+	wg.Wait()
+
+	if currentErr != nil {
+		return nil, fmt.Errorf("failed to get contributions for %d: %w", year, currentErr)
+	}
+
+	report.Totals = s.yearTotals(username, year, current)
+	s.populateWrappedDays(report, current.Days)
+	report.TopRepos, report.TopLanguages = topYearRepos(current.Repositories, 5)
+
+	if previousErr != nil {
+		warnf("failed to get contributions for %d: %v", year-1, previousErr)
+	} else {
+		totals := s.yearTotals(username, year-1, previous)
+		report.Previous = &totals
+	}
+
+	return report, nil
+}
+
+func (s *StatsCalculator) yearSummary(username string, year int) (*ContributionSummary, error) {
+	from := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(1, 0, 0).Add(-time.Second)
+	if now := time.Now().UTC(); to.After(now) {
+		to = now
+	}
+	return s.client.GetContributionSummary(username, from, to)
+}
+
+func (s *StatsCalculator) yearTotals(username string, year int, summary *ContributionSummary) YearTotals {
+	totals := YearTotals{
+		Year:               year,
+		TotalContributions: summary.TotalContributions,
+		Commits:            summary.Commits,
+		PullRequests:       summary.PullRequests,
+		Issues:             summary.Issues,
+		Reviews:            summary.Reviews,
+	}
+
+	var activeDates []time.Time
+	for _, day := range summary.Days {
+		if day.Count > 0 {
+			activeDates = append(activeDates, day.Date)
+		}
+	}
+	totals.ActiveDays = len(activeDates)
+	totals.LongestStreak = s.calculateStreaks(activeDates).MaxStreak
+
+	mergedQuery := fmt.Sprintf("author:%s is:pr is:merged merged:%d-01-01..%d-12-31", username, year, year)
+	merged, err := s.client.CountIssues(mergedQuery)
+	if err != nil {
+		warnf("failed to count merged PRs for %d: %v", year, err)
+	}
+	totals.PRsMerged = merged
+
+	return totals
+}
+
+func (s *StatsCalculator) populateWrappedDays(report *WrappedReport, days []ContributionDay) {
+	monthCount := make(map[time.Month]int)
+	var activeDates []time.Time
+
+	for _, day := range days {
+		if day.Count == 0 {
+			continue
+		}
+
+		activeDates = append(activeDates, day.Date)
+		monthCount[day.Date.Month()] += day.Count
+
+		if day.Count > report.BusiestDayCount {
+			report.BusiestDayCount = day.Count
+			report.BusiestDay = day.Date
+		}
+		if report.FirstContribution.IsZero() || day.Date.Before(report.FirstContribution) {
+			report.FirstContribution = day.Date
+		}
+		if day.Date.After(report.LastContribution) {
+			report.LastContribution = day.Date
+		}
+	}
+
+	for month := time.January; month <= time.December; month++ {
+		count := monthCount[month]
+		report.MonthlyContributions = append(report.MonthlyContributions, MonthCount{
+			Month: month.String(),
+			Count: count,
+		})
+		if count > report.BusiestMonthCount {
+			report.BusiestMonthCount = count
+			report.BusiestMonth = month.String()
+		}
+	}
+
+	streakInfo := s.calculateStreaks(activeDates)
+	report.LongestStreakStart = streakInfo.MaxStart
+	report.LongestStreakEnd = streakInfo.MaxEnd
+}
+
+func topYearRepos(repos []RepoContribution, limit int) ([]RepoCount, []LanguageCount) {
+	repoCount := make(map[string]int)
+	langCount := make(map[string]int)
+	total := 0
+
+	for _, repo := range repos {
+		repoCount[repo.RepoName] += repo.Commits
+		if repo.Language != "" {
+			langCount[repo.Language] += repo.Commits
+			total += repo.Commits
+		}
+	}
+
+	var languages []LanguageCount
+	for name, count := range langCount {
+		percentage := 0.0
+		if total > 0 {
+			percentage = float64(count) / float64(total) * 100.0
+		}
+		languages = append(languages, LanguageCount{
+			Name:       name,
+			Count:      count,
+			Percentage: percentage,
+		})
+	}
+
+	sort.Slice(languages, func(i, j int) bool {
+		return languages[i].Count > languages[j].Count
+	})
+
+	if len(languages) > limit {
+		languages = languages[:limit]
+	}
+
+	return getTopRepos(repoCount, limit), languages
+}
@@ -0,0 +1,152 @@
+package roster
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+type Entry struct {
+	Login  string
+	Team   string
+	Fields map[string]string
+}
+
+var loginColumns = []string{"login", "user", "username"}
+
+// FromLogins builds entries from usernames given on the command line,
+// normalized and deduplicated the same way as a roster file.
+func FromLogins(logins []string) ([]Entry, error) {
+	var entries []Entry
+	for _, login := range logins {
+		if login = normalizeLogin(login); login != "" {
+			entries = append(entries, Entry{Login: login})
+		}
+	}
+
+	entries = dedupe(entries)
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("no users given")
+	}
+	return entries, nil
+}
+
+func LoadEntries(path string) ([]Entry, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open roster: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	var entries []Entry
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		entries, err = parseCSV(file)
+	} else {
+		entries, err = parseLines(file)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read roster: %w", err)
+	}
+
+	entries = dedupe(entries)
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("roster %s contains no users", path)
+	}
+	return entries, nil
+}
+
+func parseLines(r io.Reader) ([]Entry, error) {
+	var entries []Entry
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		entries = append(entries, Entry{Login: normalizeLogin(line)})
+	}
+	return entries, scanner.Err()
+}
+
+func parseCSV(r io.Reader) ([]Entry, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	// Without a header row the first column is the login and the second,
+	// if present, the team.
+	header := []string{"login", "team"}
+	if isHeader(records[0]) {
+		header = records[0]
+		for i := range header {
+			header[i] = strings.ToLower(strings.TrimSpace(header[i]))
+		}
+		records = records[1:]
+	}
+
+	var entries []Entry
+	for _, record := range records {
+		entry := Entry{Fields: make(map[string]string)}
+		for i, value := range record {
+			value = strings.TrimSpace(value)
+			column := fmt.Sprintf("column%d", i+1)
+			if i < len(header) {
+				column = header[i]
+			}
+			switch {
+			case slices.Contains(loginColumns, column):
+				entry.Login = normalizeLogin(value)
+			case column == "team":
+				entry.Team = value
+			default:
+				entry.Fields[column] = value
+			}
+		}
+		if entry.Login == "" {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func isHeader(record []string) bool {
+	for _, column := range record {
+		if slices.Contains(loginColumns, strings.ToLower(strings.TrimSpace(column))) {
+			return true
+		}
+	}
+	return false
+}
+
+func dedupe(entries []Entry) []Entry {
+	seen := make(map[string]bool)
+	var unique []Entry
+	for _, entry := range entries {
+		key := strings.ToLower(entry.Login)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		unique = append(unique, entry)
+	}
+	return unique
+}
+
+func normalizeLogin(login string) string {
+	return strings.TrimPrefix(strings.TrimSpace(login), "@")
+}
@@ -0,0 +1,109 @@
+package roster
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func writeRoster(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadEntries(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		content string
+		want    []Entry
+		wantErr bool
+	}{
+		{
+			name:    "plain lines with comments and blank lines",
+			file:    "roster.txt",
+			content: "# hack week\nalice\n\n  @bob  \n# carol\n",
+			want:    []Entry{{Login: "alice"}, {Login: "bob"}},
+		},
+		{
+			name:    "plain lines deduplicated case-insensitively",
+			file:    "roster.txt",
+			content: "alice\nAlice\n@alice\nbob\n",
+			want:    []Entry{{Login: "alice"}, {Login: "bob"}},
+		},
+		{
+			name:    "csv with header",
+			file:    "team.csv",
+			content: "Team,Username,Location\nplatform,alice,Berlin\nweb,@bob,Lisbon\n",
+			want: []Entry{
+				{Login: "alice", Team: "platform", Fields: map[string]string{"location": "Berlin"}},
+				{Login: "bob", Team: "web", Fields: map[string]string{"location": "Lisbon"}},
+			},
+		},
+		{
+			name:    "csv without header",
+			file:    "team.CSV",
+			content: "alice,platform,extra\n# skipped\nbob\n",
+			want: []Entry{
+				{Login: "alice", Team: "platform", Fields: map[string]string{"column3": "extra"}},
+				{Login: "bob", Fields: map[string]string{}},
+			},
+		},
+		{
+			name:    "csv duplicates and empty logins",
+			file:    "team.csv",
+			content: "login,team\nalice,a\n,b\nALICE,c\n",
+			want:    []Entry{{Login: "alice", Team: "a", Fields: map[string]string{}}},
+		},
+		{
+			name:    "only comments",
+			file:    "roster.txt",
+			content: "# nobody\n\n",
+			wantErr: true,
+		},
+		{
+			name:    "csv header only",
+			file:    "team.csv",
+			content: "login,team\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LoadEntries(writeRoster(t, tt.file, tt.content))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadEntries() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("LoadEntries() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadEntriesMissingFile(t *testing.T) {
+	if _, err := LoadEntries(filepath.Join(t.TempDir(), "missing.txt")); err == nil {
+		t.Error("LoadEntries() error = nil, want error for missing file")
+	}
+}
+
+func TestFromLogins(t *testing.T) {
+	got, err := FromLogins([]string{"alice", "@Bob", "", "ALICE", " carol "})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Entry{{Login: "alice"}, {Login: "Bob"}, {Login: "carol"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FromLogins() = %+v, want %+v", got, want)
+	}
+
+	if _, err := FromLogins([]string{"", " "}); err == nil {
+		t.Error("FromLogins() error = nil, want error for no users")
+	}
+}
@@ -0,0 +1,247 @@
+package snapshot
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github-stats/internal/github"
+)
+
+const languageShareThreshold = 0.1
+
+type StatsDiff struct {
+	Username string
+	From     time.Time
+	To       time.Time
+
+	Followers     Change
+	TotalStars    Change
+	PRsMerged     Change
+	Reviews       Change
+	CurrentStreak Change
+	MaxStreak     Change
+
+	RepoStars      []RepoStarChange
+	LanguageShares []LanguageShareChange
+	NewFollowers   []string
+	NewMergedPRs   []github.MergedPR
+}
+
+type Change struct {
+	Before int
+	After  int
+	Delta  int
+}
+
+type RepoStarChange struct {
+	Repository string
+	Before     int
+	After      int
+	Delta      int
+	Added      bool
+	Removed    bool
+}
+
+type LanguageShareChange struct {
+	Language string
+	Before   float64
+	After    float64
+	Delta    float64
+}
+
+func LoadFile(path string) (*Snapshot, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	// Accept both stored snapshots and plain --format json output.
+	var snap Snapshot
+	if err := json.Unmarshal(data, &snap); err == nil && snap.Stats != nil {
+		return &snap, nil
+	}
+
+	var stats github.UserStats
+	if err := json.Unmarshal(data, &stats); err != nil || stats.Username == "" {
+		return nil, fmt.Errorf("%s is not a github-stats JSON file", path)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat %s: %w", path, err)
+	}
+	return &Snapshot{Timestamp: info.ModTime().UTC(), Stats: &stats}, nil
+}
+
+func Diff(before, after Snapshot) *StatsDiff {
+	b, a := before.Stats, after.Stats
+
+	diff := &StatsDiff{
+		Username:       a.Username,
+		From:           before.Timestamp,
+		To:             after.Timestamp,
+		Followers:      newChange(b.Followers, a.Followers),
+		TotalStars:     newChange(b.TotalStars, a.TotalStars),
+		PRsMerged:      newChange(mergedPRs(b), mergedPRs(a)),
+		Reviews:        newChange(reviewCount(b), reviewCount(a)),
+		CurrentStreak:  newChange(b.CurrentStreak, a.CurrentStreak),
+		MaxStreak:      newChange(b.MaxStreak, a.MaxStreak),
+		RepoStars:      make([]RepoStarChange, 0),
+		LanguageShares: make([]LanguageShareChange, 0),
+		NewFollowers:   newFollowers(b.FollowerLogins, a.FollowerLogins),
+		NewMergedPRs:   newMergedPRs(b.RecentMergedPRs, a.RecentMergedPRs, before.Timestamp),
+	}
+
+	// Repositories that were deleted or renamed only appear on one side, so
+	// walk both snapshots to report them instead of dropping them silently.
+	beforeStars, afterStars := repoStars(b), repoStars(a)
+	for repo := range union(beforeStars, afterStars) {
+		beforeCount, hadBefore := beforeStars[repo]
+		afterCount, hasAfter := afterStars[repo]
+		if hadBefore && hasAfter && beforeCount == afterCount {
+			continue
+		}
+		if !hadBefore && afterCount == 0 {
+			continue
+		}
+		diff.RepoStars = append(diff.RepoStars, RepoStarChange{
+			Repository: repo,
+			Before:     beforeCount,
+			After:      afterCount,
+			Delta:      afterCount - beforeCount,
+			Added:      !hadBefore,
+			Removed:    !hasAfter,
+		})
+	}
+	sort.Slice(diff.RepoStars, func(i, j int) bool {
+		if diff.RepoStars[i].Delta != diff.RepoStars[j].Delta {
+			return diff.RepoStars[i].Delta > diff.RepoStars[j].Delta
+		}
+		return diff.RepoStars[i].Repository < diff.RepoStars[j].Repository
+	})
+
+	beforeShares, afterShares := languageShares(b.Languages), languageShares(a.Languages)
+	for lang := range union(beforeShares, afterShares) {
+		delta := afterShares[lang] - beforeShares[lang]
+		if math.Abs(delta) < languageShareThreshold {
+			continue
+		}
+		diff.LanguageShares = append(diff.LanguageShares, LanguageShareChange{
+			Language: lang,
+			Before:   beforeShares[lang],
+			After:    afterShares[lang],
+			Delta:    delta,
+		})
+	}
+	sort.Slice(diff.LanguageShares, func(i, j int) bool {
+		di, dj := math.Abs(diff.LanguageShares[i].Delta), math.Abs(diff.LanguageShares[j].Delta)
+		if di != dj {
+			return di > dj
+		}
+		return diff.LanguageShares[i].Language < diff.LanguageShares[j].Language
+	})
+
+	return diff
+}
+
+// newFollowers is nil when either snapshot predates follower tracking or
+// the account had too many followers to list.
+func newFollowers(before, after []string) []string {
+	if before == nil || after == nil {
+		return nil
+	}
+	seen := make(map[string]bool, len(before))
+	for _, login := range before {
+		seen[strings.ToLower(login)] = true
+	}
+	followers := make([]string, 0)
+	for _, login := range after {
+		if !seen[strings.ToLower(login)] {
+			followers = append(followers, login)
+		}
+	}
+	sort.Strings(followers)
+	return followers
+}
+
+func newMergedPRs(before, after []github.MergedPR, since time.Time) []github.MergedPR {
+	seen := make(map[string]bool, len(before))
+	for _, pr := range before {
+		seen[pr.URL] = true
+	}
+	merged := make([]github.MergedPR, 0)
+	for _, pr := range after {
+		if seen[pr.URL] || !pr.MergedAt.After(since) {
+			continue
+		}
+		merged = append(merged, pr)
+	}
+	return merged
+}
+
+func newChange(before, after int) Change {
+	return Change{Before: before, After: after, Delta: after - before}
+}
+
+func mergedPRs(stats *github.UserStats) int {
+	if stats.PRStats == nil {
+		return 0
+	}
+	return stats.PRStats.Merged
+}
+
+func reviewCount(stats *github.UserStats) int {
+	if stats.ReviewStats == nil {
+		return 0
+	}
+	return stats.ReviewStats.Total
+}
+
+// repoStars falls back to the top repositories for snapshots saved before
+// per-repository star counts were recorded.
+func repoStars(stats *github.UserStats) map[string]int {
+	if len(stats.RepoStars) > 0 {
+		return stats.RepoStars
+	}
+	stars := make(map[string]int)
+	for _, repo := range stats.TopRepositories {
+		name := repo.FullName
+		if name == "" {
+			name = repo.Name
+		}
+		stars[name] = repo.Stars
+	}
+	return stars
+}
+
+func languageShares(languages map[string]int64) map[string]float64 {
+	var total int64
+	for _, weight := range languages {
+		total += weight
+	}
+
+	shares := make(map[string]float64, len(languages))
+	if total == 0 {
+		return shares
+	}
+	for lang, weight := range languages {
+		shares[lang] = float64(weight) / float64(total) * 100.0
+	}
+	return shares
+}
+
+func union[V any](a, b map[string]V) map[string]bool {
+	keys := make(map[string]bool, len(a)+len(b))
+	for k := range a {
+		keys[k] = true
+	}
+	for k := range b {
+		keys[k] = true
+	}
+	return keys
+}
@@ -0,0 +1,66 @@
+package snapshot
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github-stats/internal/github"
+)
+
+func TestDiffRepoStars(t *testing.T) {
+	before := Snapshot{Stats: &github.UserStats{RepoStars: map[string]int{
+		"me/kept":    10,
+		"me/same":    3,
+		"me/deleted": 7,
+	}}}
+	after := Snapshot{Stats: &github.UserStats{RepoStars: map[string]int{
+		"me/kept":    12,
+		"me/same":    3,
+		"me/created": 2,
+		"me/empty":   0,
+	}}}
+
+	got := Diff(before, after).RepoStars
+	want := []RepoStarChange{
+		{Repository: "me/created", Before: 0, After: 2, Delta: 2, Added: true},
+		{Repository: "me/kept", Before: 10, After: 12, Delta: 2},
+		{Repository: "me/deleted", Before: 7, After: 0, Delta: -7, Removed: true},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("RepoStars = %+v, want %+v", got, want)
+	}
+}
+
+func TestNewFollowers(t *testing.T) {
+	tests := []struct {
+		name          string
+		before, after []string
+		want          []string
+	}{
+		{"untracked before", nil, []string{"a"}, nil},
+		{"untracked after", []string{"a"}, nil, nil},
+		{"none new", []string{"a", "b"}, []string{"B"}, []string{}},
+		{"new sorted", []string{"a"}, []string{"c", "a", "b"}, []string{"b", "c"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := newFollowers(tt.before, tt.after); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("newFollowers() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewMergedPRs(t *testing.T) {
+	since := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	old := github.MergedPR{URL: "u/1", MergedAt: since.Add(-time.Hour)}
+	seen := github.MergedPR{URL: "u/2", MergedAt: since.Add(time.Hour)}
+	fresh := github.MergedPR{URL: "u/3", MergedAt: since.Add(2 * time.Hour)}
+
+	got := newMergedPRs([]github.MergedPR{seen}, []github.MergedPR{fresh, seen, old}, since)
+	if !reflect.DeepEqual(got, []github.MergedPR{fresh}) {
+		t.Errorf("newMergedPRs() = %+v, want only %s", got, fresh.URL)
+	}
+}
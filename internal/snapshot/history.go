@@ -0,0 +1,78 @@
+package snapshot
+
+import (
+	"time"
+
+	"github-stats/internal/github"
+)
+
+type History struct {
+	Username  string
+	Snapshots int
+	First     time.Time
+	Latest    time.Time
+	Metrics   []MetricTrend
+}
+
+type MetricTrend struct {
+	Name   string
+	Values []int
+	First  int
+	Latest int
+	Delta  int
+}
+
+var historyMetrics = []struct {
+	name  string
+	value func(*github.UserStats) int
+}{
+	{"Followers", func(s *github.UserStats) int { return s.Followers }},
+	{"Total Stars", func(s *github.UserStats) int { return s.TotalStars }},
+	{"Pull Requests", func(s *github.UserStats) int {
+		if s.PRStats == nil {
+			return 0
+		}
+		return s.PRStats.Total
+	}},
+	{"PRs Merged", func(s *github.UserStats) int {
+		if s.PRStats == nil {
+			return 0
+		}
+		return s.PRStats.Merged
+	}},
+	{"Reviews", func(s *github.UserStats) int {
+		if s.ReviewStats == nil {
+			return 0
+		}
+		return s.ReviewStats.Total
+	}},
+	{"Current Streak", func(s *github.UserStats) int { return s.CurrentStreak }},
+	{"Longest Streak", func(s *github.UserStats) int { return s.MaxStreak }},
+}
+
+func BuildHistory(username string, snapshots []Snapshot) *History {
+	history := &History{
+		Username:  username,
+		Snapshots: len(snapshots),
+		Metrics:   make([]MetricTrend, 0, len(historyMetrics)),
+	}
+	if len(snapshots) == 0 {
+		return history
+	}
+
+	history.First = snapshots[0].Timestamp
+	history.Latest = snapshots[len(snapshots)-1].Timestamp
+
+	for _, metric := range historyMetrics {
+		trend := MetricTrend{Name: metric.name}
+		for _, snap := range snapshots {
+			trend.Values = append(trend.Values, metric.value(snap.Stats))
+		}
+		trend.First = trend.Values[0]
+		trend.Latest = trend.Values[len(trend.Values)-1]
+		trend.Delta = trend.Latest - trend.First
+		history.Metrics = append(history.Metrics, trend)
+	}
+
+	return history
+}
@@ -0,0 +1,98 @@
+package snapshot
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github-stats/internal/github"
+)
+
+// LoadState returns the incremental state saved by the previous run, or a
+// fresh state when there is none or it cannot be read.
+func (s *Store) LoadState(username string) (*github.IncrementalState, error) {
+	data, err := os.ReadFile(s.statePath(username))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return github.NewIncrementalState(username), nil
+		}
+		return github.NewIncrementalState(username), fmt.Errorf("failed to read state file: %w", err)
+	}
+
+	var state github.IncrementalState
+	if err := json.Unmarshal(data, &state); err != nil || !strings.EqualFold(state.Username, username) {
+		return github.NewIncrementalState(username), fmt.Errorf("ignoring corrupt state file %s", s.statePath(username))
+	}
+	return &state, nil
+}
+
+func (s *Store) SaveState(state *github.IncrementalState, at time.Time) error {
+	state.UpdatedAt = at.UTC()
+	if err := s.writeStateFile(s.statePath(state.Username), state); err != nil {
+		return fmt.Errorf("failed to save state: %w", err)
+	}
+	return nil
+}
+
+// LoadCheckpoint returns the checkpoint of an interrupted full scan, or nil
+// when there is none.
+func (s *Store) LoadCheckpoint(username string) (*github.ScanCheckpoint, error) {
+	data, err := os.ReadFile(s.checkpointPath(username))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read checkpoint: %w", err)
+	}
+
+	var checkpoint github.ScanCheckpoint
+	if err := json.Unmarshal(data, &checkpoint); err != nil || !strings.EqualFold(checkpoint.Username, username) {
+		return nil, fmt.Errorf("ignoring corrupt checkpoint %s", s.checkpointPath(username))
+	}
+	return &checkpoint, nil
+}
+
+func (s *Store) SaveCheckpoint(checkpoint *github.ScanCheckpoint) error {
+	if err := s.writeStateFile(s.checkpointPath(checkpoint.Username), checkpoint); err != nil {
+		return fmt.Errorf("failed to save checkpoint: %w", err)
+	}
+	return nil
+}
+
+func (s *Store) ClearCheckpoint(username string) error {
+	if err := os.Remove(s.checkpointPath(username)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove checkpoint: %w", err)
+	}
+	return nil
+}
+
+// writeStateFile writes through a temporary file so an interrupted run never
+// leaves a truncated file behind.
+func (s *Store) writeStateFile(path string, v interface{}) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+func (s *Store) statePath(username string) string {
+	return filepath.Join(s.dir, "state", strings.ToLower(username)+".json")
+}
+
+func (s *Store) checkpointPath(username string) string {
+	return filepath.Join(s.dir, "state", strings.ToLower(username)+".scan.json")
+}